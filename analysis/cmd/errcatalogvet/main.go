@@ -0,0 +1,32 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command errcatalogvet reports mistakes in the usage of the Error Catalog,
+// see package errcatalogvet. It runs standalone or as a vet tool:
+//
+//	errcatalogvet ./...
+//	go vet -vettool=$(which errcatalogvet) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/snyk/error-catalog-golang-public/analysis/errcatalogvet"
+)
+
+func main() {
+	singlechecker.Main(errcatalogvet.Analyzer)
+}
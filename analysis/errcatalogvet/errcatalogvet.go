@@ -0,0 +1,387 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package errcatalogvet defines an analyzer reporting common mistakes in code
+// using the Error Catalog:
+//
+//   - comparing the ErrorCode of an error with the string literal of a
+//     catalog code instead of its constant in the errorcodes package
+//   - building snyk_errors.Error literals by hand instead of calling the
+//     constructor of the error
+//   - formatting an error into the detail of a catalog error with %v or %s
+//     without passing it to snyk_errors.WithCause
+//   - string literals used as error codes, such as compared with an
+//     ErrorCode or passed to the errorcodes and catalog packages, which have
+//     the prefix of a catalog namespace but are not in the catalog
+//
+// Empty snyk_errors.Error{} literals are not reported, as they are commonly
+// used as errors.As targets.
+//
+// The analyzer is part of the module
+// github.com/snyk/error-catalog-golang-public/analysis, so that the catalog
+// does not depend on golang.org/x/tools.
+package errcatalogvet
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+)
+
+const (
+	snykErrorsPath = catalog.ModulePath + "/snyk_errors"
+	errorcodesPath = catalog.ModulePath + "/errorcodes"
+	catalogPath    = catalog.ModulePath + "/catalog"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "errcatalogvet",
+	Doc:      "report mistakes in the usage of the Error Catalog\n\nSee https://pkg.go.dev/" + catalog.ModulePath + "/analysis/errcatalogvet.",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// codePattern matches strings shaped like catalog error codes, for example
+// SNYK-0001, SNYK-OS-GO-0003 or SNYK-OSJVM-001.
+var codePattern = regexp.MustCompile(`^[A-Z]+(?:-[A-Z0-9]+)*-[0-9]{3,4}$`)
+
+// codePrefixes are the prefixes of the catalog codes, everything but their
+// number, such as SNYK-OS-GO. Matching whole prefixes keeps other Snyk
+// identifiers, such as the vulnerability ID SNYK-JS-LODASH-1018905, apart.
+var codePrefixes = func() map[string]bool {
+	prefixes := make(map[string]bool)
+	for _, namespace := range errorcodes.Namespaces() {
+		for _, code := range errorcodes.Codes(namespace) {
+			prefixes[codePrefix(string(code))] = true
+		}
+	}
+
+	return prefixes
+}()
+
+func run(pass *analysis.Pass) (any, error) {
+	// the catalog itself is built from hand-written literals and raw codes
+	if pass.Pkg.Path() == catalog.ModulePath || strings.HasPrefix(pass.Pkg.Path(), catalog.ModulePath+"/") {
+		return nil, nil
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.BinaryExpr)(nil),
+		(*ast.SwitchStmt)(nil),
+		(*ast.CompositeLit)(nil),
+		(*ast.CallExpr)(nil),
+		(*ast.BasicLit)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.BinaryExpr:
+			checkComparison(pass, n)
+		case *ast.SwitchStmt:
+			checkSwitch(pass, n)
+		case *ast.CompositeLit:
+			checkLiteral(pass, n)
+		case *ast.CallExpr:
+			checkDetail(pass, n)
+			checkCodeArgs(pass, n)
+		case *ast.BasicLit:
+			if isNamed(pass.TypesInfo.TypeOf(n), errorcodesPath, "ErrorCode") {
+				checkCode(pass, n)
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+func checkComparison(pass *analysis.Pass, n *ast.BinaryExpr) {
+	if n.Op != token.EQL && n.Op != token.NEQ {
+		return
+	}
+
+	switch {
+	case isErrorCode(pass, n.X):
+		reportLiteralCode(pass, n.Y)
+	case isErrorCode(pass, n.Y):
+		reportLiteralCode(pass, n.X)
+	}
+}
+
+func checkSwitch(pass *analysis.Pass, n *ast.SwitchStmt) {
+	if n.Tag == nil || !isErrorCode(pass, n.Tag) {
+		return
+	}
+
+	for _, stmt := range n.Body.List {
+		for _, expr := range stmt.(*ast.CaseClause).List {
+			reportLiteralCode(pass, expr)
+		}
+	}
+}
+
+// reportLiteralCode reports expr if it is the string literal of a code
+// compared with an ErrorCode: its constant if the code is in the catalog, or
+// the unknown code if it only looks like one. Other literals, such as "" to
+// check for uncatalogued errors, are not reported.
+func reportLiteralCode(pass *analysis.Pass, expr ast.Expr) {
+	lit, ok := ast.Unparen(expr).(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+
+	code, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+
+	if namespace, field, ok := errorcodes.NameOf(code); ok {
+		pass.Reportf(lit.Pos(), "compare ErrorCode with errorcodes.%s.%s instead of the literal %q", namespace, field, code)
+		return
+	}
+
+	checkCode(pass, lit)
+}
+
+func checkLiteral(pass *analysis.Pass, n *ast.CompositeLit) {
+	if len(n.Elts) == 0 || !isNamed(pass.TypesInfo.TypeOf(n), snykErrorsPath, "Error") {
+		return
+	}
+
+	pass.Reportf(n.Pos(), "snyk_errors.Error built by hand; use the constructor of the error from the catalog instead")
+
+	for _, elt := range n.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "ErrorCode" {
+			if lit, ok := ast.Unparen(kv.Value).(*ast.BasicLit); ok {
+				checkCode(pass, lit)
+			}
+		}
+	}
+}
+
+// checkCodeArgs checks the string literals passed to the functions of the
+// errorcodes and catalog packages, which take codes. Literals converted to
+// errorcodes.ErrorCode are checked on their own.
+func checkCodeArgs(pass *analysis.Pass, n *ast.CallExpr) {
+	fn, ok := calledFunc(pass, n.Fun)
+	if !ok || fn.Pkg() == nil || (fn.Pkg().Path() != errorcodesPath && fn.Pkg().Path() != catalogPath) {
+		return
+	}
+
+	for _, arg := range n.Args {
+		lit, ok := ast.Unparen(arg).(*ast.BasicLit)
+		if ok && !isNamed(pass.TypesInfo.TypeOf(lit), errorcodesPath, "ErrorCode") {
+			checkCode(pass, lit)
+		}
+	}
+}
+
+// checkDetail reports calls to catalog constructors whose detail formats an
+// error with fmt.Sprintf, unless the error is also passed with WithCause.
+func checkDetail(pass *analysis.Pass, n *ast.CallExpr) {
+	if !isConstructor(pass, n) || n.Ellipsis.IsValid() {
+		return
+	}
+
+	sprintf, ok := ast.Unparen(n.Args[0]).(*ast.CallExpr)
+	if !ok || !isFunc(pass, sprintf.Fun, "fmt", "Sprintf") || sprintf.Ellipsis.IsValid() || len(sprintf.Args) < 2 {
+		return
+	}
+
+	for _, option := range n.Args[1:] {
+		if call, ok := ast.Unparen(option).(*ast.CallExpr); ok && isFunc(pass, call.Fun, snykErrorsPath, "WithCause") {
+			return
+		}
+	}
+
+	format, ok := constantString(pass, sprintf.Args[0])
+	if !ok {
+		return
+	}
+
+	verbs, ok := parseVerbs(format)
+	if !ok {
+		return
+	}
+
+	errorType := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	for i, verb := range verbs {
+		if i+1 >= len(sprintf.Args) || (verb != 'v' && verb != 's') {
+			continue
+		}
+
+		arg := sprintf.Args[i+1]
+		if t := pass.TypesInfo.TypeOf(arg); t != nil && types.Implements(t, errorType) {
+			pass.Reportf(arg.Pos(), "error formatted into the detail with %%%c; pass it with snyk_errors.WithCause to keep it in the chain", verb)
+			return
+		}
+	}
+}
+
+func checkCode(pass *analysis.Pass, n *ast.BasicLit) {
+	if n.Kind != token.STRING {
+		return
+	}
+
+	code, err := strconv.Unquote(n.Value)
+	if err != nil || !looksLikeCode(code) {
+		return
+	}
+
+	if !errorcodes.ErrorCode(code).Valid() {
+		pass.Reportf(n.Pos(), "unknown error code %q: it is not in the Error Catalog", code)
+	}
+}
+
+func looksLikeCode(s string) bool {
+	return codePattern.MatchString(s) && codePrefixes[codePrefix(s)]
+}
+
+func codePrefix(code string) string {
+	return code[:strings.LastIndex(code, "-")]
+}
+
+// isErrorCode reports whether expr selects the ErrorCode field of a
+// snyk_errors.Error.
+func isErrorCode(pass *analysis.Pass, expr ast.Expr) bool {
+	sel, ok := ast.Unparen(expr).(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "ErrorCode" {
+		return false
+	}
+
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return false
+	}
+
+	return isNamed(selection.Recv(), snykErrorsPath, "Error")
+}
+
+// isConstructor reports whether call calls an error constructor of the
+// catalog, a function of the module taking a detail and options.
+func isConstructor(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := calledFunc(pass, call.Fun)
+	if !ok || fn.Pkg() == nil || !strings.HasPrefix(fn.Pkg().Path(), catalog.ModulePath+"/") || fn.Pkg().Path() == errorcodesPath {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() != nil || !sig.Variadic() || sig.Params().Len() != 2 || sig.Results().Len() != 1 || len(call.Args) < 1 {
+		return false
+	}
+
+	detail, ok := sig.Params().At(0).Type().(*types.Basic)
+
+	return ok && detail.Kind() == types.String && isNamed(sig.Results().At(0).Type(), snykErrorsPath, "Error")
+}
+
+func isFunc(pass *analysis.Pass, expr ast.Expr, pkg, name string) bool {
+	fn, ok := calledFunc(pass, expr)
+
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == pkg && fn.Name() == name
+}
+
+func calledFunc(pass *analysis.Pass, expr ast.Expr) (*types.Func, bool) {
+	var id *ast.Ident
+
+	switch fun := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return nil, false
+	}
+
+	fn, ok := pass.TypesInfo.Uses[id].(*types.Func)
+
+	return fn, ok
+}
+
+func isNamed(t types.Type, pkg, name string) bool {
+	if t == nil {
+		return false
+	}
+
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkg && obj.Name() == name
+}
+
+func constantString(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
+// parseVerbs returns the verbs of a printf format in argument order. It gives
+// up on explicit argument indexes and * widths, which it does not track.
+func parseVerbs(format string) ([]rune, bool) {
+	var verbs []rune
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		for i++; i < len(format) && strings.ContainsRune("+-# 0123456789.", rune(format[i])); i++ {
+		}
+
+		if i >= len(format) {
+			break
+		}
+
+		switch format[i] {
+		case '%':
+			continue
+		case '[', '*':
+			return nil, false
+		}
+
+		verbs = append(verbs, rune(format[i]))
+	}
+
+	return verbs, true
+}
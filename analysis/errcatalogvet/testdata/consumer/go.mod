@@ -0,0 +1,12 @@
+module example.com/consumer
+
+go 1.22.0
+
+require github.com/snyk/error-catalog-golang-public v0.0.0
+
+require (
+	github.com/google/uuid v1.3.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/snyk/error-catalog-golang-public => ../../../..
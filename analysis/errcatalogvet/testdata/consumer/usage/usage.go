@@ -0,0 +1,91 @@
+package usage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func compare(err snyk_errors.Error, ptr *snyk_errors.Error) bool {
+	if err.ErrorCode == errorcodes.Snyk.TooManyRequestsError {
+		return true
+	}
+
+	if err.ErrorCode == "SNYK-0001" { // want `compare ErrorCode with errorcodes.Snyk.TooManyRequestsError instead of the literal "SNYK-0001"`
+		return true
+	}
+
+	if "SNYK-CLI-0008" != ptr.ErrorCode { // want `compare ErrorCode with errorcodes.CLI.NoSupportedFilesFoundError instead of the literal "SNYK-CLI-0008"`
+		return false
+	}
+
+	if err.ErrorCode == "" {
+		return false
+	}
+
+	if err.ErrorCode == "SNYK-PYTHON-FOO-1234" {
+		return false
+	}
+
+	return err.ErrorCode == "not-a-code"
+}
+
+func dispatch(err snyk_errors.Error) string {
+	switch err.ErrorCode {
+	case errorcodes.Snyk.BadRequestError:
+		return "bad request"
+	case "SNYK-0005": // want `compare ErrorCode with errorcodes.Snyk.UnauthorisedError instead of the literal "SNYK-0005"`
+		return "unauthorised"
+	case "SNYK-0000": // want `unknown error code "SNYK-0000": it is not in the Error Catalog`
+		return "unknown"
+	}
+
+	return ""
+}
+
+func literals() []snyk_errors.Error {
+	var target snyk_errors.Error
+	_ = errors.As(errors.New("x"), &target)
+
+	return []snyk_errors.Error{
+		{},
+		snyk_errors.Error{}, // empty literals are errors.As targets
+		{Title: "Broken", ErrorCode: "SNYK-0003"},                  // want `snyk_errors.Error built by hand`
+		snyk_errors.Error{Title: "Broken", ErrorCode: "SNYK-9998"}, // want `snyk_errors.Error built by hand` `unknown error code "SNYK-9998"`
+	}
+}
+
+func details(err error, opts []snyk_errors.Option) []snyk_errors.Error {
+	return []snyk_errors.Error{
+		snyk.NewBadRequestError(fmt.Sprintf("request failed: %v", err)),                                // want `error formatted into the detail with %v; pass it with snyk_errors.WithCause`
+		snyk.NewBadRequestError(fmt.Sprintf("%d attempts: %s", 3, err)),                                // want `error formatted into the detail with %s; pass it with snyk_errors.WithCause`
+		snyk.NewBadRequestError(fmt.Sprintf("request failed: %+v", err), snyk_errors.WithMeta("a", 1)), // want `error formatted into the detail with %v`
+		snyk.NewBadRequestError(fmt.Sprintf("request failed: %v", err), snyk_errors.WithCause(err)),
+		snyk.NewBadRequestError(fmt.Sprintf("request failed: %v", err), opts...),
+		snyk.NewBadRequestError(fmt.Sprintf("request failed with %q", "quoted")),
+		snyk.NewBadRequestError(fmt.Sprintf("100%% of %v", 3)),
+		snyk.NewBadRequestError(fmt.Sprintf("%[1]v", err)),
+	}
+}
+
+func codes() []string {
+	_ = errorcodes.ErrorCode("SNYK-OS-GO-0999")        // want `unknown error code "SNYK-OS-GO-0999"`
+	_, _, _ = errorcodes.NameOf("SNYK-OSJVM-999")      // want `unknown error code "SNYK-OSJVM-999"`
+	_, _ = catalog.Lookup("PR-FAILURES-0999")          // want `unknown error code "PR-FAILURES-0999"`
+	_ = errorcodes.ErrorCode("SNYK-JS-LODASH-1018905") // vulnerability IDs are not codes
+
+	// only literals used as codes are checked
+	return []string{
+		"SNYK-OS-GO-0003",
+		"SNYK-OS-GO-0999",
+		"SNYK-JS-LODASH-1018905",
+		"SNYK-PYTHON-FOO-1234",
+		"SNYK-OS-1234",
+		"ACME-0001",
+		"SNYK-OS",
+	}
+}
@@ -0,0 +1,15 @@
+module github.com/snyk/error-catalog-golang-public/analysis
+
+go 1.22.0
+
+require (
+	github.com/snyk/error-catalog-golang-public v0.0.0-20261019032554-840b96723a5d
+	golang.org/x/tools v0.30.0
+)
+
+require (
+	github.com/google/uuid v1.3.1 // indirect
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,201 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package catalog exposes the whole Error Catalog as data, for documentation,
+// support tooling and services which are not written in Go.
+//
+// The catalog is also available as JSON and YAML documents, which are
+// generated alongside the namespace packages and embedded in this package.
+package catalog
+
+//go:generate go run ../internal/codegen/gencatalog -spec ../spec/catalog.yaml -root ..
+//go:generate go run ./internal/exportgen
+
+import (
+	_ "embed"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// ModulePath is the import path of the module containing the namespace
+// packages.
+const ModulePath = "github.com/snyk/error-catalog-golang-public"
+
+// Entry describes a single error of the catalog.
+type Entry struct {
+	Namespace      string   `json:"namespace" yaml:"namespace"`
+	Group          string   `json:"group,omitempty" yaml:"group,omitempty"`
+	Package        string   `json:"package" yaml:"package"`
+	Function       string   `json:"function" yaml:"function"`
+	Code           string   `json:"code" yaml:"code"`
+	Title          string   `json:"title" yaml:"title"`
+	Description    string   `json:"description" yaml:"description"`
+	StatusCode     int      `json:"statusCode" yaml:"statusCode"`
+	Classification string   `json:"classification" yaml:"classification"`
+	Level          string   `json:"level" yaml:"level"`
+	Type           string   `json:"type" yaml:"type"`
+	Anchor         string   `json:"anchor" yaml:"anchor"`
+	Links          []string `json:"links" yaml:"links"`
+	// Deprecated is set for retired codes.
+	Deprecated *Deprecation `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+}
+
+type constructor struct {
+	namespace  string
+	group      string
+	pkg        string
+	function   string
+	code       string
+	new        func(detail string, options ...snyk_errors.Option) snyk_errors.Error
+	deprecated *Deprecation
+	// nonHTTPStatus and legacy are the nonHTTPStatus and legacy fields of
+	// the spec, see Verify.
+	nonHTTPStatus bool
+	legacy        string
+}
+
+var (
+	//go:embed catalog.json
+	catalogJSON []byte
+
+	//go:embed catalog.yaml
+	catalogYAML []byte
+
+	entriesOnce sync.Once
+	entries     []Entry
+	byCode      map[string]int
+)
+
+func load() {
+	entries = make([]Entry, 0, len(registry))
+	byCode = make(map[string]int, len(registry))
+
+	for _, c := range registry {
+		err := c.new("")
+
+		entry := Entry{
+			Namespace:      c.namespace,
+			Group:          c.group,
+			Package:        ModulePath + "/" + c.pkg,
+			Function:       c.function,
+			Code:           err.ErrorCode,
+			Title:          err.Title,
+			Description:    err.Description,
+			StatusCode:     err.StatusCode,
+			Classification: err.Classification,
+			Level:          err.Level,
+			Type:           err.Type,
+			Links:          err.Links,
+			Deprecated:     c.deprecated,
+		}
+
+		if i := strings.LastIndex(err.Type, "#"); i >= 0 {
+			entry.Anchor = err.Type[i+1:]
+		}
+
+		if entry.Links == nil {
+			entry.Links = []string{}
+		}
+
+		byCode[entry.Code] = len(entries)
+		entries = append(entries, entry)
+	}
+}
+
+// All returns every entry of the catalog, in catalog order.
+func All() []Entry {
+	entriesOnce.Do(load)
+
+	result := make([]Entry, len(entries))
+	for i, entry := range entries {
+		result[i] = entry.clone()
+	}
+
+	return result
+}
+
+// Lookup returns the entry of the given error code. Deprecated codes with a
+// replacement resolve to the entry of their canonical code, see Canonical.
+func Lookup(code string) (Entry, bool) {
+	entriesOnce.Do(load)
+
+	i, ok := byCode[Canonical(code)]
+	if !ok {
+		return Entry{}, false
+	}
+
+	return entries[i].clone(), true
+}
+
+// LookupExact returns the entry of exactly the given error code. Unlike
+// Lookup, it does not follow deprecations.
+func LookupExact(code string) (Entry, bool) {
+	entriesOnce.Do(load)
+
+	i, ok := byCode[code]
+	if !ok {
+		return Entry{}, false
+	}
+
+	return entries[i].clone(), true
+}
+
+// JSON returns the embedded JSON rendition of the catalog.
+func JSON() []byte {
+	return append([]byte(nil), catalogJSON...)
+}
+
+// YAML returns the embedded YAML rendition of the catalog.
+func YAML() []byte {
+	return append([]byte(nil), catalogYAML...)
+}
+
+// EncodeJSON writes entries to w as an indented JSON array.
+func EncodeJSON(w io.Writer, entries []Entry) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(entries)
+}
+
+// EncodeYAML writes entries to w as a YAML sequence.
+func EncodeYAML(w io.Writer, entries []Entry) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(entries); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+func (e Entry) clone() Entry {
+	e.Links = append([]string{}, e.Links...)
+	if e.Deprecated != nil {
+		deprecated := *e.Deprecated
+		e.Deprecated = &deprecated
+	}
+
+	return e
+}
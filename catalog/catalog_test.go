@@ -0,0 +1,106 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package catalog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+)
+
+func TestAll(t *testing.T) {
+	entries := catalog.All()
+
+	require.Len(t, entries, 301)
+	require.Equal(t, catalog.Entry{
+		Namespace:      "Snyk",
+		Package:        "github.com/snyk/error-catalog-golang-public/snyk",
+		Function:       "NewTooManyRequestsError",
+		Code:           errorcodes.Snyk.TooManyRequestsError,
+		Title:          "Service temporarily throttled",
+		Description:    "The request rate limit has been exceeded. Wait a few minutes, then try again.",
+		StatusCode:     429,
+		Classification: "ACTIONABLE",
+		Level:          "warn",
+		Type:           "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-0001",
+		Anchor:         "snyk-0001",
+		Links:          []string{},
+	}, entries[0])
+}
+
+func TestAllReturnsCopies(t *testing.T) {
+	entry, ok := catalog.Lookup(errorcodes.Snyk.NotImplementedError)
+	require.True(t, ok)
+
+	catalog.All()[1].Links[0] = "changed"
+	entry.Links[0] = "changed"
+
+	actual, _ := catalog.Lookup(errorcodes.Snyk.NotImplementedError)
+	require.Equal(t, []string{"https://docs.snyk.io/snyk-api-info"}, actual.Links)
+}
+
+func TestLookup(t *testing.T) {
+	entry, ok := catalog.Lookup(errorcodes.OpenSourceEcosystems.GoModFileMissingError)
+	require.True(t, ok)
+	require.Equal(t, "OpenSourceEcosystems", entry.Namespace)
+	require.Equal(t, "NewGoModFileMissingError", entry.Function)
+	require.Equal(t, "snyk-os-go-0002", entry.Anchor)
+
+	_, ok = catalog.Lookup("SNYK-UNKNOWN-0001")
+	require.False(t, ok)
+}
+
+func TestLookupExact(t *testing.T) {
+	entry, ok := catalog.LookupExact("SNYK-OS-GO-0003")
+	require.True(t, ok)
+	require.Equal(t, "SNYK-OS-GO-0003", entry.Code)
+	require.NotNil(t, entry.Deprecated)
+
+	entry, ok = catalog.Lookup("SNYK-OS-GO-0003")
+	require.True(t, ok)
+	require.Equal(t, "SNYK-OS-8004", entry.Code)
+
+	_, ok = catalog.LookupExact("SNYK-UNKNOWN-0001")
+	require.False(t, ok)
+}
+
+func TestEmbeddedJSONIsUpToDate(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, catalog.EncodeJSON(&buf, catalog.All()))
+
+	require.Equal(t, buf.String(), string(catalog.JSON()), "run go generate ./catalog")
+
+	var entries []catalog.Entry
+	require.NoError(t, json.Unmarshal(catalog.JSON(), &entries))
+	require.Equal(t, catalog.All(), entries)
+}
+
+func TestEmbeddedYAMLIsUpToDate(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, catalog.EncodeYAML(&buf, catalog.All()))
+
+	require.Equal(t, buf.String(), string(catalog.YAML()), "run go generate ./catalog")
+
+	var entries []catalog.Entry
+	require.NoError(t, yaml.Unmarshal(catalog.YAML(), &entries))
+	require.Equal(t, catalog.All(), entries)
+}
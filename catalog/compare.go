@@ -0,0 +1,160 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package catalog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ChangeKind is the kind of a change between two versions of the catalog.
+type ChangeKind string
+
+const (
+	// ChangeRemoved is a code which is no longer in the catalog.
+	ChangeRemoved ChangeKind = "removed"
+	// ChangeAdded is a new code.
+	ChangeAdded ChangeKind = "added"
+	// ChangeStatusCode is a change of the HTTP status code of an error.
+	ChangeStatusCode ChangeKind = "statusCode"
+	// ChangeClassification is a change of the classification of an error.
+	ChangeClassification ChangeKind = "classification"
+	// ChangeLevel is a change of the level of an error, which affects alert
+	// routing in both directions.
+	ChangeLevel ChangeKind = "level"
+	// ChangeConstructor is a renamed or moved constructor.
+	ChangeConstructor ChangeKind = "constructor"
+	// ChangeType is a change of the documentation URL of an error, usually of
+	// its anchor.
+	ChangeType ChangeKind = "type"
+	// ChangeDeprecated is a code which has been deprecated.
+	ChangeDeprecated ChangeKind = "deprecated"
+)
+
+// Change is a difference between two versions of the catalog affecting a
+// single code.
+type Change struct {
+	Code string     `json:"code" yaml:"code"`
+	Kind ChangeKind `json:"kind" yaml:"kind"`
+	// Old and New are the values before and after the change, empty for
+	// added and removed codes.
+	Old string `json:"old,omitempty" yaml:"old,omitempty"`
+	New string `json:"new,omitempty" yaml:"new,omitempty"`
+	// Breaking is set for changes which require consumers to change their
+	// code or configuration.
+	Breaking bool `json:"breaking" yaml:"breaking"`
+}
+
+func (c Change) String() string {
+	switch {
+	case c.Old == "" && c.New == "":
+		return fmt.Sprintf("%s %s", c.Code, c.Kind)
+	case c.Old == "":
+		return fmt.Sprintf("%s %s: %s", c.Code, c.Kind, c.New)
+	default:
+		return fmt.Sprintf("%s %s: %s -> %s", c.Code, c.Kind, c.Old, c.New)
+	}
+}
+
+// ParseExport decodes a JSON or YAML export of the catalog, as written by
+// EncodeJSON and EncodeYAML.
+func ParseExport(data []byte) ([]Entry, error) {
+	var entries []Entry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parsing catalog export: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Compare reports the changes between the old and new exports of the
+// catalog. Changes to codes of old are reported in the order of old, followed
+// by the codes added in new.
+//
+// Removed codes and changes of the status code, classification, level,
+// constructor and type of an error are breaking: services pinning the module
+// route, alert on and link to errors based on them. Added and deprecated
+// codes are not, and neither are constructors moved into the package of a
+// group, as the namespace package keeps a constructor for them.
+func Compare(old, new []Entry) []Change {
+	byCode := make(map[string]Entry, len(new))
+	for _, e := range new {
+		byCode[e.Code] = e
+	}
+
+	var changes []Change
+	seen := make(map[string]bool, len(old))
+
+	for _, o := range old {
+		seen[o.Code] = true
+
+		n, ok := byCode[o.Code]
+		if !ok {
+			changes = append(changes, Change{Code: o.Code, Kind: ChangeRemoved, Breaking: true})
+			continue
+		}
+
+		changes = append(changes, compareEntries(o, n)...)
+	}
+
+	for _, n := range new {
+		if !seen[n.Code] {
+			changes = append(changes, Change{Code: n.Code, Kind: ChangeAdded})
+		}
+	}
+
+	return changes
+}
+
+// Breaking returns the breaking changes of changes.
+func Breaking(changes []Change) []Change {
+	var breaking []Change
+	for _, c := range changes {
+		if c.Breaking {
+			breaking = append(breaking, c)
+		}
+	}
+
+	return breaking
+}
+
+func compareEntries(o, n Entry) []Change {
+	var changes []Change
+
+	changed := func(kind ChangeKind, old, new string, breaking bool) {
+		if old != new {
+			changes = append(changes, Change{Code: o.Code, Kind: kind, Old: old, New: new, Breaking: breaking})
+		}
+	}
+
+	changed(ChangeStatusCode, strconv.Itoa(o.StatusCode), strconv.Itoa(n.StatusCode), true)
+	changed(ChangeClassification, o.Classification, n.Classification, true)
+	changed(ChangeLevel, o.Level, n.Level, true)
+
+	movedToGroup := o.Function == n.Function && o.Group == "" && n.Group != "" && strings.HasPrefix(n.Package, o.Package+"/")
+	changed(ChangeConstructor, o.Package+"."+o.Function, n.Package+"."+n.Function, !movedToGroup)
+
+	changed(ChangeType, o.Type, n.Type, true)
+
+	if o.Deprecated == nil && n.Deprecated != nil {
+		changes = append(changes, Change{Code: o.Code, Kind: ChangeDeprecated, New: n.Deprecated.ReplacedBy})
+	}
+
+	return changes
+}
@@ -0,0 +1,149 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package catalog_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+)
+
+func TestParseExport(t *testing.T) {
+	fromJSON, err := catalog.ParseExport(catalog.JSON())
+	require.NoError(t, err)
+	require.Equal(t, catalog.All(), fromJSON)
+
+	fromYAML, err := catalog.ParseExport(catalog.YAML())
+	require.NoError(t, err)
+	require.Equal(t, catalog.All(), fromYAML)
+
+	_, err = catalog.ParseExport([]byte(`{"code": "SNYK-0001"}`))
+	require.ErrorContains(t, err, "parsing catalog export")
+}
+
+func TestCompare(t *testing.T) {
+	base := catalog.Entry{
+		Namespace:      "OpenSourceEcosystems",
+		Package:        "github.com/snyk/error-catalog-golang-public/opensource/ecosystems",
+		Function:       "NewBrokenError",
+		Code:           "SNYK-OS-0001",
+		StatusCode:     400,
+		Classification: "ACTIONABLE",
+		Level:          "warn",
+		Type:           "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-0001",
+	}
+
+	type test struct {
+		description string
+		change      func(e *catalog.Entry)
+		expected    []catalog.Change
+	}
+
+	tests := []test{
+		{
+			description: "unchanged",
+			change:      func(e *catalog.Entry) { e.Title = "Retitled" },
+		},
+		{
+			description: "status code",
+			change:      func(e *catalog.Entry) { e.StatusCode = 422 },
+			expected:    []catalog.Change{{Code: "SNYK-OS-0001", Kind: catalog.ChangeStatusCode, Old: "400", New: "422", Breaking: true}},
+		},
+		{
+			description: "classification and level",
+			change: func(e *catalog.Entry) {
+				e.Classification = "UNEXPECTED"
+				e.Level = "error"
+			},
+			expected: []catalog.Change{
+				{Code: "SNYK-OS-0001", Kind: catalog.ChangeClassification, Old: "ACTIONABLE", New: "UNEXPECTED", Breaking: true},
+				{Code: "SNYK-OS-0001", Kind: catalog.ChangeLevel, Old: "warn", New: "error", Breaking: true},
+			},
+		},
+		{
+			description: "renamed constructor",
+			change:      func(e *catalog.Entry) { e.Function = "NewBrokenManifestError" },
+			expected: []catalog.Change{{
+				Code:     "SNYK-OS-0001",
+				Kind:     catalog.ChangeConstructor,
+				Old:      base.Package + ".NewBrokenError",
+				New:      base.Package + ".NewBrokenManifestError",
+				Breaking: true,
+			}},
+		},
+		{
+			description: "constructor moved into a group",
+			change: func(e *catalog.Entry) {
+				e.Group = "Go"
+				e.Package += "/golang"
+			},
+			expected: []catalog.Change{{
+				Code: "SNYK-OS-0001",
+				Kind: catalog.ChangeConstructor,
+				Old:  base.Package + ".NewBrokenError",
+				New:  base.Package + "/golang.NewBrokenError",
+			}},
+		},
+		{
+			description: "type anchor",
+			change:      func(e *catalog.Entry) { e.Type = "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0001" },
+			expected: []catalog.Change{{
+				Code:     "SNYK-OS-0001",
+				Kind:     catalog.ChangeType,
+				Old:      base.Type,
+				New:      "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0001",
+				Breaking: true,
+			}},
+		},
+		{
+			description: "deprecated",
+			change:      func(e *catalog.Entry) { e.Deprecated = &catalog.Deprecation{Since: "1.1.0", ReplacedBy: "SNYK-OS-0002"} },
+			expected:    []catalog.Change{{Code: "SNYK-OS-0001", Kind: catalog.ChangeDeprecated, New: "SNYK-OS-0002"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			changed := base
+			tt.change(&changed)
+
+			require.Equal(t, tt.expected, catalog.Compare([]catalog.Entry{base}, []catalog.Entry{changed}))
+		})
+	}
+}
+
+func TestCompare_AddedAndRemoved(t *testing.T) {
+	first := catalog.Entry{Code: "SNYK-0001"}
+	second := catalog.Entry{Code: "SNYK-0002"}
+	third := catalog.Entry{Code: "SNYK-0003"}
+
+	changes := catalog.Compare([]catalog.Entry{first, second}, []catalog.Entry{third, first})
+	require.Equal(t, []catalog.Change{
+		{Code: "SNYK-0002", Kind: catalog.ChangeRemoved, Breaking: true},
+		{Code: "SNYK-0003", Kind: catalog.ChangeAdded},
+	}, changes)
+	require.Equal(t, changes[:1], catalog.Breaking(changes))
+
+	require.Equal(t, "SNYK-0002 removed", changes[0].String())
+	require.Empty(t, catalog.Compare(catalog.All(), catalog.All()))
+}
+
+func TestChange_String(t *testing.T) {
+	require.Equal(t, "SNYK-0001 level: warn -> error", catalog.Change{Code: "SNYK-0001", Kind: catalog.ChangeLevel, Old: "warn", New: "error"}.String())
+	require.Equal(t, "SNYK-0001 deprecated: SNYK-0002", catalog.Change{Code: "SNYK-0001", Kind: catalog.ChangeDeprecated, New: "SNYK-0002"}.String())
+}
@@ -0,0 +1,87 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalog
+
+import (
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// MetaKeyDeprecatedErrorCode is the meta key under which RewriteDeprecated
+// keeps the original code of a rewritten error.
+const MetaKeyDeprecatedErrorCode = "deprecatedErrorCode"
+
+// Deprecation describes when and why an error code was retired.
+type Deprecation struct {
+	// Since is the catalog version which deprecated the code.
+	Since string `json:"since" yaml:"since"`
+	// ReplacedBy is the code superseding the deprecated one, if any.
+	ReplacedBy string `json:"replacedBy,omitempty" yaml:"replacedBy,omitempty"`
+	Notice     string `json:"notice,omitempty" yaml:"notice,omitempty"`
+}
+
+// Canonical returns the code which replaces code, following replacements of
+// replacements. Codes which are not deprecated, deprecated without
+// replacement or unknown are returned unchanged.
+func Canonical(code string) string {
+	entriesOnce.Do(load)
+
+	for range entries {
+		i, ok := byCode[code]
+		if !ok || entries[i].Deprecated == nil || entries[i].Deprecated.ReplacedBy == "" {
+			break
+		}
+		code = entries[i].Deprecated.ReplacedBy
+	}
+
+	return code
+}
+
+// RewriteDeprecated returns an option replacing a deprecated code of an error
+// with its canonical code, keeping the original code in the meta under
+// MetaKeyDeprecatedErrorCode. All other fields owned by the catalog, such as
+// the title, level and documentation link, are also taken from the canonical
+// entry, while the detail, meta and other fields of the occurrence are kept.
+// It is meant for decoding errors of peers built with an older catalog:
+//
+//	errs, err := snyk_errors.FromJSONAPIErrorBytes(data, catalog.RewriteDeprecated())
+func RewriteDeprecated() snyk_errors.Option {
+	return func(e *snyk_errors.Error) {
+		canonical := Canonical(e.ErrorCode)
+		if canonical == e.ErrorCode {
+			return
+		}
+
+		entry, ok := Lookup(canonical)
+		if !ok {
+			return
+		}
+
+		if e.Meta == nil {
+			e.Meta = make(map[string]any)
+		}
+		e.Meta[MetaKeyDeprecatedErrorCode] = e.ErrorCode
+
+		e.ErrorCode = entry.Code
+		e.Type = entry.Type
+		e.Title = entry.Title
+		e.Description = entry.Description
+		e.StatusCode = entry.StatusCode
+		e.Classification = entry.Classification
+		e.Level = entry.Level
+		e.Links = entry.Links
+	}
+}
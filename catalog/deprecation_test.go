@@ -0,0 +1,130 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package catalog_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/isolatedbuilds"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/golang"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+const deprecatedCode = "SNYK-OS-GO-0003"
+
+func TestDeprecatedEntry(t *testing.T) {
+	var deprecated []catalog.Entry
+	for _, entry := range catalog.All() {
+		if entry.Deprecated != nil {
+			deprecated = append(deprecated, entry)
+		}
+	}
+
+	require.Len(t, deprecated, 1)
+	require.Equal(t, deprecatedCode, deprecated[0].Code)
+	require.Equal(t, &catalog.Deprecation{
+		Since:      "1.0.0",
+		ReplacedBy: errorcodes.IsolatedBuilds.SsoReAuthRequiredError,
+		Notice:     "This error has been moved to a more generalized namespace to avoid repetition.  Use SNYK-OS-8004 instead.",
+	}, deprecated[0].Deprecated)
+}
+
+func TestCanonical(t *testing.T) {
+	type test struct {
+		description string
+		code        string
+		expected    string
+	}
+
+	tests := []test{
+		{description: "deprecated", code: deprecatedCode, expected: errorcodes.IsolatedBuilds.SsoReAuthRequiredError},
+		{description: "canonical", code: errorcodes.Snyk.BadRequestError, expected: errorcodes.Snyk.BadRequestError},
+		{description: "unknown", code: "SNYK-UNKNOWN-0001", expected: "SNYK-UNKNOWN-0001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.expected, catalog.Canonical(tt.code))
+		})
+	}
+}
+
+func TestLookup_ResolvesDeprecated(t *testing.T) {
+	entry, ok := catalog.Lookup(deprecatedCode)
+	require.True(t, ok)
+	require.Equal(t, errorcodes.IsolatedBuilds.SsoReAuthRequiredError, entry.Code)
+	require.Equal(t, "IsolatedBuilds", entry.Namespace)
+	require.Nil(t, entry.Deprecated)
+}
+
+func TestRewriteDeprecated(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, golang.NewSsoReAuthRequiredError("re-authenticate").MarshalToJSONAPIError(&buf, ""))
+	require.NoError(t, isolatedbuilds.NewSsoReAuthRequiredError("re-authenticate").MarshalToJSONAPIError(&buf, ""))
+
+	docs := bytes.SplitAfter(buf.Bytes(), []byte("\n"))
+
+	errs, err := snyk_errors.FromJSONAPIErrorBytes(docs[0], catalog.RewriteDeprecated())
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, errorcodes.IsolatedBuilds.SsoReAuthRequiredError, errs[0].ErrorCode)
+	require.Equal(t, "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-8004", errs[0].Type)
+	require.Equal(t, deprecatedCode, errs[0].Meta[catalog.MetaKeyDeprecatedErrorCode])
+	require.Equal(t, "re-authenticate", errs[0].Detail)
+
+	errs, err = snyk_errors.FromJSONAPIErrorBytes(docs[1], catalog.RewriteDeprecated())
+	require.NoError(t, err)
+	require.Equal(t, errorcodes.IsolatedBuilds.SsoReAuthRequiredError, errs[0].ErrorCode)
+	require.NotContains(t, errs[0].Meta, catalog.MetaKeyDeprecatedErrorCode)
+}
+
+func TestRewriteDeprecated_CatalogFields(t *testing.T) {
+	err := snyk_errors.Error{
+		ErrorCode:      deprecatedCode,
+		Title:          "Stale title",
+		Description:    "Stale description.",
+		StatusCode:     500,
+		Classification: "UNEXPECTED",
+		Level:          "warn",
+		Links:          []string{"https://example.com/stale"},
+		Detail:         "re-authenticate",
+	}
+	catalog.RewriteDeprecated()(&err)
+
+	expected := isolatedbuilds.NewSsoReAuthRequiredError("re-authenticate")
+	require.Equal(t, expected.ErrorCode, err.ErrorCode)
+	require.Equal(t, expected.Type, err.Type)
+	require.Equal(t, expected.Title, err.Title)
+	require.Equal(t, expected.Description, err.Description)
+	require.Equal(t, expected.StatusCode, err.StatusCode)
+	require.Equal(t, expected.Classification, err.Classification)
+	require.Equal(t, expected.Level, err.Level)
+	require.Equal(t, expected.Links, err.Links)
+	require.Equal(t, "re-authenticate", err.Detail)
+}
+
+func TestRewriteDeprecated_WithoutMeta(t *testing.T) {
+	err := snyk_errors.Error{ErrorCode: deprecatedCode}
+	catalog.RewriteDeprecated()(&err)
+
+	require.Equal(t, errorcodes.IsolatedBuilds.SsoReAuthRequiredError, err.ErrorCode)
+	require.Equal(t, map[string]any{catalog.MetaKeyDeprecatedErrorCode: deprecatedCode}, err.Meta)
+}
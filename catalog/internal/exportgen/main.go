@@ -0,0 +1,46 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command exportgen writes the JSON and YAML renditions of the catalog which
+// are embedded in the catalog package. Run it through go generate from the
+// catalog directory.
+package main
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"os"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+)
+
+func main() {
+	write("catalog.json", catalog.EncodeJSON)
+	write("catalog.yaml", catalog.EncodeYAML)
+}
+
+func write(path string, encode func(w io.Writer, entries []catalog.Entry) error) {
+	var buf bytes.Buffer
+
+	if err := encode(&buf, catalog.All()); err != nil {
+		log.Fatalf("encoding %s: %v", path, err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		log.Fatalf("writing %s: %v", path, err)
+	}
+}
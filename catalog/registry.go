@@ -0,0 +1,354 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package catalog
+
+import (
+	"github.com/snyk/error-catalog-golang-public/aibom"
+	"github.com/snyk/error-catalog-golang-public/cli"
+	"github.com/snyk/error-catalog-golang-public/code"
+	"github.com/snyk/error-catalog-golang-public/custombaseimages"
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/fix"
+	"github.com/snyk/error-catalog-golang-public/integration"
+	"github.com/snyk/error-catalog-golang-public/isolatedbuilds"
+	"github.com/snyk/error-catalog-golang-public/openapi"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/dotnet"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/golang"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/maven"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/nodejs"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/python"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/ruby"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/settings"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/uv"
+	"github.com/snyk/error-catalog-golang-public/opensource/project/issues"
+	"github.com/snyk/error-catalog-golang-public/opensource/project/snapshots"
+	"github.com/snyk/error-catalog-golang-public/opensource/unmanaged"
+	"github.com/snyk/error-catalog-golang-public/policies"
+	"github.com/snyk/error-catalog-golang-public/prchecks"
+	"github.com/snyk/error-catalog-golang-public/purl/vulnerabilities"
+	"github.com/snyk/error-catalog-golang-public/sbomexport"
+	"github.com/snyk/error-catalog-golang-public/sbomtest"
+	"github.com/snyk/error-catalog-golang-public/scm"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/target"
+	"github.com/snyk/error-catalog-golang-public/uploadrevision"
+)
+
+// registry lists the constructors of every error in the catalog, in catalog order.
+var registry = []constructor{
+	{namespace: "Snyk", pkg: "snyk", function: "NewTooManyRequestsError", code: errorcodes.Snyk.TooManyRequestsError, new: snyk.NewTooManyRequestsError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewNotImplementedError", code: errorcodes.Snyk.NotImplementedError, new: snyk.NewNotImplementedError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewBadRequestError", code: errorcodes.Snyk.BadRequestError, new: snyk.NewBadRequestError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewTimeoutError", code: errorcodes.Snyk.TimeoutError, new: snyk.NewTimeoutError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewUnauthorisedError", code: errorcodes.Snyk.UnauthorisedError, new: snyk.NewUnauthorisedError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewTestLimitReachedError", code: errorcodes.Snyk.TestLimitReachedError, new: snyk.NewTestLimitReachedError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewTagsForOrganizationWithoutGroupError", code: errorcodes.Snyk.TagsForOrganizationWithoutGroupError, new: snyk.NewTagsForOrganizationWithoutGroupError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewBadGatewayError", code: errorcodes.Snyk.BadGatewayError, new: snyk.NewBadGatewayError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewServiceUnavailableError", code: errorcodes.Snyk.ServiceUnavailableError, new: snyk.NewServiceUnavailableError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewRequirementsNotMetError", code: errorcodes.Snyk.RequirementsNotMetError, new: snyk.NewRequirementsNotMetError, nonHTTPStatus: true},
+	{namespace: "Snyk", pkg: "snyk", function: "NewMaintenanceWindowError", code: errorcodes.Snyk.MaintenanceWindowError, new: snyk.NewMaintenanceWindowError},
+	{namespace: "Snyk", pkg: "snyk", function: "NewServerError", code: errorcodes.Snyk.ServerError, new: snyk.NewServerError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewUnparseableManifestError", code: errorcodes.OpenSourceEcosystems.UnparseableManifestError, new: ecosystems.NewUnparseableManifestError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewUnparseableLockFileError", code: errorcodes.OpenSourceEcosystems.UnparseableLockFileError, new: ecosystems.NewUnparseableLockFileError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewUnknownDependencyVersionError", code: errorcodes.OpenSourceEcosystems.UnknownDependencyVersionError, new: ecosystems.NewUnknownDependencyVersionError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewMissingHeaderError", code: errorcodes.OpenSourceEcosystems.MissingHeaderError, new: ecosystems.NewMissingHeaderError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewMissingPayloadError", code: errorcodes.OpenSourceEcosystems.MissingPayloadError, new: ecosystems.NewMissingPayloadError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewUnprocessableFileError", code: errorcodes.OpenSourceEcosystems.UnprocessableFileError, new: ecosystems.NewUnprocessableFileError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewCannotGetFileFromSourceError", code: errorcodes.OpenSourceEcosystems.CannotGetFileFromSourceError, new: ecosystems.NewCannotGetFileFromSourceError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewMissingEnvironmentVariableError", code: errorcodes.OpenSourceEcosystems.MissingEnvironmentVariableError, new: ecosystems.NewMissingEnvironmentVariableError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewBrokeredConnectionNotSupportedError", code: errorcodes.OpenSourceEcosystems.BrokeredConnectionNotSupportedError, new: ecosystems.NewBrokeredConnectionNotSupportedError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewGitCloneFailedError", code: errorcodes.OpenSourceEcosystems.GitCloneFailedError, new: ecosystems.NewGitCloneFailedError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewUnsupportedPlatformError", code: errorcodes.OpenSourceEcosystems.UnsupportedPlatformError, new: ecosystems.NewUnsupportedPlatformError},
+	{namespace: "OpenSourceEcosystems", pkg: "opensource/ecosystems", function: "NewEmptyManifestError", code: errorcodes.OpenSourceEcosystems.EmptyManifestError, new: ecosystems.NewEmptyManifestError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewUnsupportedManifestFileError", code: errorcodes.OpenSourceEcosystems.UnsupportedManifestFileError, new: dotnet.NewUnsupportedManifestFileError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewUnsupportedTargetFrameworkError", code: errorcodes.OpenSourceEcosystems.UnsupportedTargetFrameworkError, new: dotnet.NewUnsupportedTargetFrameworkError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewMissingStaticMainFunctionError", code: errorcodes.OpenSourceEcosystems.MissingStaticMainFunctionError, new: dotnet.NewMissingStaticMainFunctionError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewPublishFailedError", code: errorcodes.OpenSourceEcosystems.PublishFailedError, new: dotnet.NewPublishFailedError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewFailedToAccessPrivatePackageSourceError", code: errorcodes.OpenSourceEcosystems.FailedToAccessPrivatePackageSourceError, new: dotnet.NewFailedToAccessPrivatePackageSourceError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewMissingMSBuildConditionError", code: errorcodes.OpenSourceEcosystems.MissingMSBuildConditionError, new: dotnet.NewMissingMSBuildConditionError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewNoTargetFrameworksFoundError", code: errorcodes.OpenSourceEcosystems.NoTargetFrameworksFoundError, new: dotnet.NewNoTargetFrameworksFoundError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewOutdatedSDKVersionRequestedError", code: errorcodes.OpenSourceEcosystems.OutdatedSDKVersionRequestedError, new: dotnet.NewOutdatedSDKVersionRequestedError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewProjectSkippedAndNotFoundError", code: errorcodes.OpenSourceEcosystems.ProjectSkippedAndNotFoundError, new: dotnet.NewProjectSkippedAndNotFoundError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewNugetDependenciesSpaceLimitExceededError", code: errorcodes.OpenSourceEcosystems.NugetDependenciesSpaceLimitExceededError, new: dotnet.NewNugetDependenciesSpaceLimitExceededError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewRestoreFailedError", code: errorcodes.OpenSourceEcosystems.RestoreFailedError, new: dotnet.NewRestoreFailedError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewCpmVersionOverrideError", code: errorcodes.OpenSourceEcosystems.CpmVersionOverrideError, new: dotnet.NewCpmVersionOverrideError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewCpmMissingPackageVersionError", code: errorcodes.OpenSourceEcosystems.CpmMissingPackageVersionError, new: dotnet.NewCpmMissingPackageVersionError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewCpmDisabledOrMissingVersionError", code: errorcodes.OpenSourceEcosystems.CpmDisabledOrMissingVersionError, new: dotnet.NewCpmDisabledOrMissingVersionError},
+	{namespace: "OpenSourceEcosystems", group: "DotNet", pkg: "opensource/ecosystems/dotnet", function: "NewIncompatibleTargetFrameworkError", code: errorcodes.OpenSourceEcosystems.IncompatibleTargetFrameworkError, new: dotnet.NewIncompatibleTargetFrameworkError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewPrivateModuleError", code: errorcodes.OpenSourceEcosystems.PrivateModuleError, new: golang.NewPrivateModuleError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGoModFileMissingError", code: errorcodes.OpenSourceEcosystems.GoModFileMissingError, new: golang.NewGoModFileMissingError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewSsoReAuthRequiredError", code: errorcodes.OpenSourceEcosystems.SsoReAuthRequiredError, new: golang.NewSsoReAuthRequiredError, deprecated: &Deprecation{Since: "1.0.0", ReplacedBy: "SNYK-OS-8004", Notice: "This error has been moved to a more generalized namespace to avoid repetition.  Use SNYK-OS-8004 instead."}},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewIncompleteProjectError", code: errorcodes.OpenSourceEcosystems.IncompleteProjectError, new: golang.NewIncompleteProjectError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewInconsistentVendoringError", code: errorcodes.OpenSourceEcosystems.InconsistentVendoringError, new: golang.NewInconsistentVendoringError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewUnsupportedExternalFileGenerationSCMError", code: errorcodes.OpenSourceEcosystems.UnsupportedExternalFileGenerationSCMError, new: golang.NewUnsupportedExternalFileGenerationSCMError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewUnableToAccessPrivateDepsError", code: errorcodes.OpenSourceEcosystems.UnableToAccessPrivateDepsError, new: golang.NewUnableToAccessPrivateDepsError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewUnableToUseCredentialsError", code: errorcodes.OpenSourceEcosystems.UnableToUseCredentialsError, new: golang.NewUnableToUseCredentialsError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewToolchainNotAvailableError", code: errorcodes.OpenSourceEcosystems.ToolchainNotAvailableError, new: golang.NewToolchainNotAvailableError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangSpaceLimitExceededError", code: errorcodes.OpenSourceEcosystems.GolangSpaceLimitExceededError, new: golang.NewGolangSpaceLimitExceededError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangNoSecureProtocolFoundError", code: errorcodes.OpenSourceEcosystems.GolangNoSecureProtocolFoundError, new: golang.NewGolangNoSecureProtocolFoundError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangConnectionResetByPeerError", code: errorcodes.OpenSourceEcosystems.GolangConnectionResetByPeerError, new: golang.NewGolangConnectionResetByPeerError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangInvalidZipFileError", code: errorcodes.OpenSourceEcosystems.GolangInvalidZipFileError, new: golang.NewGolangInvalidZipFileError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangVersionMismatchError", code: errorcodes.OpenSourceEcosystems.GolangVersionMismatchError, new: golang.NewGolangVersionMismatchError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangInvalidGoVersionError", code: errorcodes.OpenSourceEcosystems.GolangInvalidGoVersionError, new: golang.NewGolangInvalidGoVersionError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangDialTcpTimeoutError", code: errorcodes.OpenSourceEcosystems.GolangDialTcpTimeoutError, new: golang.NewGolangDialTcpTimeoutError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangHostKeyVerificationFailedError", code: errorcodes.OpenSourceEcosystems.GolangHostKeyVerificationFailedError, new: golang.NewGolangHostKeyVerificationFailedError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangMissingModuleDeclarationError", code: errorcodes.OpenSourceEcosystems.GolangMissingModuleDeclarationError, new: golang.NewGolangMissingModuleDeclarationError},
+	{namespace: "OpenSourceEcosystems", group: "Go", pkg: "opensource/ecosystems/golang", function: "NewGolangModuleVersionConstraintNotMetError", code: errorcodes.OpenSourceEcosystems.GolangModuleVersionConstraintNotMetError, new: golang.NewGolangModuleVersionConstraintNotMetError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewMissingRequirementFromPomError", code: errorcodes.OpenSourceEcosystems.MissingRequirementFromPomError, new: maven.NewMissingRequirementFromPomError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewUnableToResolveValueForPropertyError", code: errorcodes.OpenSourceEcosystems.UnableToResolveValueForPropertyError, new: maven.NewUnableToResolveValueForPropertyError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewUnableToResolveVersionForPropertyError", code: errorcodes.OpenSourceEcosystems.UnableToResolveVersionForPropertyError, new: maven.NewUnableToResolveVersionForPropertyError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewCyclicPropertyDetectedInPomFileError", code: errorcodes.OpenSourceEcosystems.CyclicPropertyDetectedInPomFileError, new: maven.NewCyclicPropertyDetectedInPomFileError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewUnableToParseXMLError", code: errorcodes.OpenSourceEcosystems.UnableToParseXMLError, new: maven.NewUnableToParseXMLError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewInvalidCoordinatesError", code: errorcodes.OpenSourceEcosystems.InvalidCoordinatesError, new: maven.NewInvalidCoordinatesError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewSkippedGroupError", code: errorcodes.OpenSourceEcosystems.SkippedGroupError, new: maven.NewSkippedGroupError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewPomFileNotFoundError", code: errorcodes.OpenSourceEcosystems.PomFileNotFoundError, new: maven.NewPomFileNotFoundError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewMissingProjectFromPomError", code: errorcodes.OpenSourceEcosystems.MissingProjectFromPomError, new: maven.NewMissingProjectFromPomError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewCannotResolveTargetPomFromXmlError", code: errorcodes.OpenSourceEcosystems.CannotResolveTargetPomFromXmlError, new: maven.NewCannotResolveTargetPomFromXmlError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewCannotResolveTargetPomFromRepoError", code: errorcodes.OpenSourceEcosystems.CannotResolveTargetPomFromRepoError, new: maven.NewCannotResolveTargetPomFromRepoError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewCannotGetBuildFileFromRepoError", code: errorcodes.OpenSourceEcosystems.CannotGetBuildFileFromRepoError, new: maven.NewCannotGetBuildFileFromRepoError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewCannotCreateGitHostError", code: errorcodes.OpenSourceEcosystems.CannotCreateGitHostError, new: maven.NewCannotCreateGitHostError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewNoReleasedVersionForVersionsRangeError", code: errorcodes.OpenSourceEcosystems.NoReleasedVersionForVersionsRangeError, new: maven.NewNoReleasedVersionForVersionsRangeError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewSourceNotSupportedError", code: errorcodes.OpenSourceEcosystems.SourceNotSupportedError, new: maven.NewSourceNotSupportedError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewTimeoutWhenProcessingTheDepTreeError", code: errorcodes.OpenSourceEcosystems.TimeoutWhenProcessingTheDepTreeError, new: maven.NewTimeoutWhenProcessingTheDepTreeError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewCannotReachConfiguredRepositoryError", code: errorcodes.OpenSourceEcosystems.CannotReachConfiguredRepositoryError, new: maven.NewCannotReachConfiguredRepositoryError},
+	{namespace: "OpenSourceEcosystems", group: "Maven", pkg: "opensource/ecosystems/maven", function: "NewFailedToBuildMavenProjectError", code: errorcodes.OpenSourceEcosystems.FailedToBuildMavenProjectError, new: maven.NewFailedToBuildMavenProjectError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewNoRepoFoundForTheNPMPackageError", code: errorcodes.OpenSourceEcosystems.NoRepoFoundForTheNPMPackageError, new: nodejs.NewNoRepoFoundForTheNPMPackageError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewCouldNotParseNPMRegistryURLError", code: errorcodes.OpenSourceEcosystems.CouldNotParseNPMRegistryURLError, new: nodejs.NewCouldNotParseNPMRegistryURLError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewCouldNotFindBrokerURLError", code: errorcodes.OpenSourceEcosystems.CouldNotFindBrokerURLError, new: nodejs.NewCouldNotFindBrokerURLError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewUnableToReplaceBrokerURLError", code: errorcodes.OpenSourceEcosystems.UnableToReplaceBrokerURLError, new: nodejs.NewUnableToReplaceBrokerURLError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewBadNPMVersionError", code: errorcodes.OpenSourceEcosystems.BadNPMVersionError, new: nodejs.NewBadNPMVersionError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewUnknownBlobEncodingOnGithubError", code: errorcodes.OpenSourceEcosystems.UnknownBlobEncodingOnGithubError, new: nodejs.NewUnknownBlobEncodingOnGithubError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewNoResultsFromForkerProcessesError", code: errorcodes.OpenSourceEcosystems.NoResultsFromForkerProcessesError, new: nodejs.NewNoResultsFromForkerProcessesError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewChildProcessExecutionError", code: errorcodes.OpenSourceEcosystems.ChildProcessExecutionError, new: nodejs.NewChildProcessExecutionError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewNoValidPackageUpgradesError", code: errorcodes.OpenSourceEcosystems.NoValidPackageUpgradesError, new: nodejs.NewNoValidPackageUpgradesError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewNoDependencyUpdatesError", code: errorcodes.OpenSourceEcosystems.NoDependencyUpdatesError, new: nodejs.NewNoDependencyUpdatesError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewCouldNotParseJSONFileError", code: errorcodes.OpenSourceEcosystems.CouldNotParseJSONFileError, new: nodejs.NewCouldNotParseJSONFileError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewBase64EncodeError", code: errorcodes.OpenSourceEcosystems.Base64EncodeError, new: nodejs.NewBase64EncodeError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewBase64DecodeError", code: errorcodes.OpenSourceEcosystems.Base64DecodeError, new: nodejs.NewBase64DecodeError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewMissingSupportedFileError", code: errorcodes.OpenSourceEcosystems.MissingSupportedFileError, new: nodejs.NewMissingSupportedFileError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewInvalidConfigurationError", code: errorcodes.OpenSourceEcosystems.InvalidConfigurationError, new: nodejs.NewInvalidConfigurationError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewPnpmOutOfSyncError", code: errorcodes.OpenSourceEcosystems.PnpmOutOfSyncError, new: nodejs.NewPnpmOutOfSyncError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewPnpmUnsupportedLockfileVersionError", code: errorcodes.OpenSourceEcosystems.PnpmUnsupportedLockfileVersionError, new: nodejs.NewPnpmUnsupportedLockfileVersionError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewYarnPackageNotFoundError", code: errorcodes.OpenSourceEcosystems.YarnPackageNotFoundError, new: nodejs.NewYarnPackageNotFoundError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewUnableToReachRegistryError", code: errorcodes.OpenSourceEcosystems.UnableToReachRegistryError, new: nodejs.NewUnableToReachRegistryError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewOutdatedYarnLockFileError", code: errorcodes.OpenSourceEcosystems.OutdatedYarnLockFileError, new: nodejs.NewOutdatedYarnLockFileError},
+	{namespace: "OpenSourceEcosystems", group: "NodeJS", pkg: "opensource/ecosystems/nodejs", function: "NewPermissionDeniedError", code: errorcodes.OpenSourceEcosystems.PermissionDeniedError, new: nodejs.NewPermissionDeniedError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewUnsupportedRequirementsFileError", code: errorcodes.OpenSourceEcosystems.UnsupportedRequirementsFileError, new: python.NewUnsupportedRequirementsFileError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewTooManyManifestFilesError", code: errorcodes.OpenSourceEcosystems.TooManyManifestFilesError, new: python.NewTooManyManifestFilesError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewFailedToApplyDependencyUpdatesError", code: errorcodes.OpenSourceEcosystems.FailedToApplyDependencyUpdatesError, new: python.NewFailedToApplyDependencyUpdatesError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPythonPackageNotFoundError", code: errorcodes.OpenSourceEcosystems.PythonPackageNotFoundError, new: python.NewPythonPackageNotFoundError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewSyntaxIssuesError", code: errorcodes.OpenSourceEcosystems.SyntaxIssuesError, new: python.NewSyntaxIssuesError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPipUnsupportedPythonVersionError", code: errorcodes.OpenSourceEcosystems.PipUnsupportedPythonVersionError, new: python.NewPipUnsupportedPythonVersionError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPythonVersionConfictError", code: errorcodes.OpenSourceEcosystems.PythonVersionConfictError, new: python.NewPythonVersionConfictError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPipNoMatchingPythonDistributionError", code: errorcodes.OpenSourceEcosystems.PipNoMatchingPythonDistributionError, new: python.NewPipNoMatchingPythonDistributionError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewInstallationFailureError", code: errorcodes.OpenSourceEcosystems.InstallationFailureError, new: python.NewInstallationFailureError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPipenvUnsupportedPythonVersionError", code: errorcodes.OpenSourceEcosystems.PipenvUnsupportedPythonVersionError, new: python.NewPipenvUnsupportedPythonVersionError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPipenvNoMatchingPythonDistributionError", code: errorcodes.OpenSourceEcosystems.PipenvNoMatchingPythonDistributionError, new: python.NewPipenvNoMatchingPythonDistributionError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPythonDependenciesSpaceLimitExceededError", code: errorcodes.OpenSourceEcosystems.PythonDependenciesSpaceLimitExceededError, new: python.NewPythonDependenciesSpaceLimitExceededError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPythonRequiredPackagesMissingError", code: errorcodes.OpenSourceEcosystems.PythonRequiredPackagesMissingError, new: python.NewPythonRequiredPackagesMissingError},
+	{namespace: "OpenSourceEcosystems", group: "Python", pkg: "opensource/ecosystems/python", function: "NewPythonFailedToWriteTempFilesError", code: errorcodes.OpenSourceEcosystems.PythonFailedToWriteTempFilesError, new: python.NewPythonFailedToWriteTempFilesError},
+	{namespace: "OpenSourceEcosystems", group: "Ruby", pkg: "opensource/ecosystems/ruby", function: "NewCyclicDependencyDetectedError", code: errorcodes.OpenSourceEcosystems.CyclicDependencyDetectedError, new: ruby.NewCyclicDependencyDetectedError},
+	{namespace: "OpenSourceEcosystems", group: "Ruby", pkg: "opensource/ecosystems/ruby", function: "NewGemNotFoundError", code: errorcodes.OpenSourceEcosystems.GemNotFoundError, new: ruby.NewGemNotFoundError},
+	{namespace: "OpenSourceEcosystems", group: "Ruby", pkg: "opensource/ecosystems/ruby", function: "NewGemVersionConflictError", code: errorcodes.OpenSourceEcosystems.GemVersionConflictError, new: ruby.NewGemVersionConflictError},
+	{namespace: "OpenSourceEcosystems", group: "Settings", pkg: "opensource/ecosystems/settings", function: "NewReachabilitySettingDisabledError", code: errorcodes.OpenSourceEcosystems.ReachabilitySettingDisabledError, new: settings.NewReachabilitySettingDisabledError},
+	{namespace: "OpenSourceEcosystems", group: "UV", pkg: "opensource/ecosystems/uv", function: "NewUvNoProjectRootError", code: errorcodes.OpenSourceEcosystems.UvNoProjectRootError, new: uv.NewUvNoProjectRootError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewOrganizationNotWhitelistedError", code: errorcodes.PurlVulnerabilityFetching.OrganizationNotWhitelistedError, new: vulnerabilities.NewOrganizationNotWhitelistedError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewAuthorizationRequestFailureError", code: errorcodes.PurlVulnerabilityFetching.AuthorizationRequestFailureError, new: vulnerabilities.NewAuthorizationRequestFailureError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewInvalidPurlError", code: errorcodes.PurlVulnerabilityFetching.InvalidPurlError, new: vulnerabilities.NewInvalidPurlError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewNamespaceNotProvidedError", code: errorcodes.PurlVulnerabilityFetching.NamespaceNotProvidedError, new: vulnerabilities.NewNamespaceNotProvidedError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewUnsupportedEcosystemError", code: errorcodes.PurlVulnerabilityFetching.UnsupportedEcosystemError, new: vulnerabilities.NewUnsupportedEcosystemError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewMissingComponentError", code: errorcodes.PurlVulnerabilityFetching.MissingComponentError, new: vulnerabilities.NewMissingComponentError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewComponentNotSupportedError", code: errorcodes.PurlVulnerabilityFetching.ComponentNotSupportedError, new: vulnerabilities.NewComponentNotSupportedError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewPackageNotFoundError", code: errorcodes.PurlVulnerabilityFetching.PackageNotFoundError, new: vulnerabilities.NewPackageNotFoundError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewVulnerabilityServiceUnavailableError", code: errorcodes.PurlVulnerabilityFetching.VulnerabilityServiceUnavailableError, new: vulnerabilities.NewVulnerabilityServiceUnavailableError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewVulnDBInvalidResponseError", code: errorcodes.PurlVulnerabilityFetching.VulnDBInvalidResponseError, new: vulnerabilities.NewVulnDBInvalidResponseError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewVulndbNextError", code: errorcodes.PurlVulnerabilityFetching.VulndbNextError, new: vulnerabilities.NewVulndbNextError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewInternalServerError", code: errorcodes.PurlVulnerabilityFetching.InternalServerError, new: vulnerabilities.NewInternalServerError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewInvalidPaginationParametersError", code: errorcodes.PurlVulnerabilityFetching.InvalidPaginationParametersError, new: vulnerabilities.NewInvalidPaginationParametersError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewTooManyPurlsError", code: errorcodes.PurlVulnerabilityFetching.TooManyPurlsError, new: vulnerabilities.NewTooManyPurlsError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewTooManyIssuesError", code: errorcodes.PurlVulnerabilityFetching.TooManyIssuesError, new: vulnerabilities.NewTooManyIssuesError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewUndefinedContainerDistroError", code: errorcodes.PurlVulnerabilityFetching.UndefinedContainerDistroError, new: vulnerabilities.NewUndefinedContainerDistroError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewUnsupportedDebianDistroError", code: errorcodes.PurlVulnerabilityFetching.UnsupportedDebianDistroError, new: vulnerabilities.NewUnsupportedDebianDistroError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewUndefinedContainerVendorError", code: errorcodes.PurlVulnerabilityFetching.UndefinedContainerVendorError, new: vulnerabilities.NewUndefinedContainerVendorError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewUnsupportedContainerVendorError", code: errorcodes.PurlVulnerabilityFetching.UnsupportedContainerVendorError, new: vulnerabilities.NewUnsupportedContainerVendorError},
+	{namespace: "PurlVulnerabilityFetching", pkg: "purl/vulnerabilities", function: "NewUnsupportedAlpineDistroError", code: errorcodes.PurlVulnerabilityFetching.UnsupportedAlpineDistroError, new: vulnerabilities.NewUnsupportedAlpineDistroError},
+	{namespace: "IsolatedBuilds", pkg: "isolatedbuilds", function: "NewInvalidRequestError", code: errorcodes.IsolatedBuilds.InvalidRequestError, new: isolatedbuilds.NewInvalidRequestError},
+	{namespace: "IsolatedBuilds", pkg: "isolatedbuilds", function: "NewBuildEnvironmentNotFoundError", code: errorcodes.IsolatedBuilds.BuildEnvironmentNotFoundError, new: isolatedbuilds.NewBuildEnvironmentNotFoundError},
+	{namespace: "IsolatedBuilds", pkg: "isolatedbuilds", function: "NewUnsupportedEcosystemError", code: errorcodes.IsolatedBuilds.UnsupportedEcosystemError, new: isolatedbuilds.NewUnsupportedEcosystemError},
+	{namespace: "IsolatedBuilds", pkg: "isolatedbuilds", function: "NewSsoReAuthRequiredError", code: errorcodes.IsolatedBuilds.SsoReAuthRequiredError, new: isolatedbuilds.NewSsoReAuthRequiredError},
+	{namespace: "IsolatedBuilds", pkg: "isolatedbuilds", function: "NewProjectTooBigError", code: errorcodes.IsolatedBuilds.ProjectTooBigError, new: isolatedbuilds.NewProjectTooBigError},
+	{namespace: "IsolatedBuilds", pkg: "isolatedbuilds", function: "NewDefaultImageNotFoundError", code: errorcodes.IsolatedBuilds.DefaultImageNotFoundError, new: isolatedbuilds.NewDefaultImageNotFoundError},
+	{namespace: "OpenSourceProjectSnapshots", pkg: "opensource/project/snapshots", function: "NewInvalidRequestError", code: errorcodes.OpenSourceProjectSnapshots.InvalidRequestError, new: snapshots.NewInvalidRequestError},
+	{namespace: "OpenSourceProjectSnapshots", pkg: "opensource/project/snapshots", function: "NewInvalidResponseError", code: errorcodes.OpenSourceProjectSnapshots.InvalidResponseError, new: snapshots.NewInvalidResponseError},
+	{namespace: "OpenSourceProjectSnapshots", pkg: "opensource/project/snapshots", function: "NewDataTransformationError", code: errorcodes.OpenSourceProjectSnapshots.DataTransformationError, new: snapshots.NewDataTransformationError},
+	{namespace: "OpenSourceProjectSnapshots", pkg: "opensource/project/snapshots", function: "NewStorageFailureError", code: errorcodes.OpenSourceProjectSnapshots.StorageFailureError, new: snapshots.NewStorageFailureError},
+	{namespace: "OpenSourceProjectSnapshots", pkg: "opensource/project/snapshots", function: "NewInternalServerError", code: errorcodes.OpenSourceProjectSnapshots.InternalServerError, new: snapshots.NewInternalServerError},
+	{namespace: "OpenSourceProjectIssues", pkg: "opensource/project/issues", function: "NewInvalidRequestError", code: errorcodes.OpenSourceProjectIssues.InvalidRequestError, new: issues.NewInvalidRequestError},
+	{namespace: "OpenSourceProjectIssues", pkg: "opensource/project/issues", function: "NewInvalidResponseError", code: errorcodes.OpenSourceProjectIssues.InvalidResponseError, new: issues.NewInvalidResponseError},
+	{namespace: "OpenSourceProjectIssues", pkg: "opensource/project/issues", function: "NewDataTransformationError", code: errorcodes.OpenSourceProjectIssues.DataTransformationError, new: issues.NewDataTransformationError},
+	{namespace: "OpenSourceProjectIssues", pkg: "opensource/project/issues", function: "NewStorageFailureError", code: errorcodes.OpenSourceProjectIssues.StorageFailureError, new: issues.NewStorageFailureError},
+	{namespace: "OpenSourceProjectIssues", pkg: "opensource/project/issues", function: "NewInternalServerError", code: errorcodes.OpenSourceProjectIssues.InternalServerError, new: issues.NewInternalServerError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewBadRequestError", code: errorcodes.OpenAPI.BadRequestError, new: openapi.NewBadRequestError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewForbiddenError", code: errorcodes.OpenAPI.ForbiddenError, new: openapi.NewForbiddenError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewNotAcceptableError", code: errorcodes.OpenAPI.NotAcceptableError, new: openapi.NewNotAcceptableError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewNotFoundError", code: errorcodes.OpenAPI.NotFoundError, new: openapi.NewNotFoundError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewMethodNotAllowedError", code: errorcodes.OpenAPI.MethodNotAllowedError, new: openapi.NewMethodNotAllowedError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewRequestEntityTooLargeError", code: errorcodes.OpenAPI.RequestEntityTooLargeError, new: openapi.NewRequestEntityTooLargeError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewUnauthorizedError", code: errorcodes.OpenAPI.UnauthorizedError, new: openapi.NewUnauthorizedError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewUnsupportedMediaTypeError", code: errorcodes.OpenAPI.UnsupportedMediaTypeError, new: openapi.NewUnsupportedMediaTypeError},
+	{namespace: "OpenAPI", pkg: "openapi", function: "NewConflictError", code: errorcodes.OpenAPI.ConflictError, new: openapi.NewConflictError},
+	{namespace: "OpenSourceUnmanaged", pkg: "opensource/unmanaged", function: "NewMavenSearchServiceUnavailableError", code: errorcodes.OpenSourceUnmanaged.MavenSearchServiceUnavailableError, new: unmanaged.NewMavenSearchServiceUnavailableError, legacy: "Numbered with three digits before IDs were four digits wide. Renumbering it would break clients matching the published code."},
+	{namespace: "OpenSourceUnmanaged", pkg: "opensource/unmanaged", function: "NewSha1NotFoundError", code: errorcodes.OpenSourceUnmanaged.Sha1NotFoundError, new: unmanaged.NewSha1NotFoundError, legacy: "Numbered with three digits before IDs were four digits wide. Renumbering it would break clients matching the published code."},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewInternalServerError", code: errorcodes.SbomExport.InternalServerError, new: sbomexport.NewInternalServerError},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewUnexpectedDepGraphResponseError", code: errorcodes.SbomExport.UnexpectedDepGraphResponseError, new: sbomexport.NewUnexpectedDepGraphResponseError},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewUnexpectedParseDepGraphError", code: errorcodes.SbomExport.UnexpectedParseDepGraphError, new: sbomexport.NewUnexpectedParseDepGraphError},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewIaCOrSASTProjectError", code: errorcodes.SbomExport.IaCOrSASTProjectError, new: sbomexport.NewIaCOrSASTProjectError},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewUnsupportedProjectError", code: errorcodes.SbomExport.UnsupportedProjectError, new: sbomexport.NewUnsupportedProjectError},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewDepGraphResponseError", code: errorcodes.SbomExport.DepGraphResponseError, new: sbomexport.NewDepGraphResponseError},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewMissingAuthTokenError", code: errorcodes.SbomExport.MissingAuthTokenError, new: sbomexport.NewMissingAuthTokenError},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewEmptyRequestBodyError", code: errorcodes.SbomExport.EmptyRequestBodyError, new: sbomexport.NewEmptyRequestBodyError},
+	{namespace: "SbomExport", pkg: "sbomexport", function: "NewInvalidDepGraphError", code: errorcodes.SbomExport.InvalidDepGraphError, new: sbomexport.NewInvalidDepGraphError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewInternalError", code: errorcodes.SbomTest.InternalError, new: sbomtest.NewInternalError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewOrgIDMismatchError", code: errorcodes.SbomTest.OrgIDMismatchError, new: sbomtest.NewOrgIDMismatchError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewNotFoundError", code: errorcodes.SbomTest.NotFoundError, new: sbomtest.NewNotFoundError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewFailedTestError", code: errorcodes.SbomTest.FailedTestError, new: sbomtest.NewFailedTestError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewPendingTestError", code: errorcodes.SbomTest.PendingTestError, new: sbomtest.NewPendingTestError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewFormatUnknownError", code: errorcodes.SbomTest.FormatUnknownError, new: sbomtest.NewFormatUnknownError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewUnprocessableInputError", code: errorcodes.SbomTest.UnprocessableInputError, new: sbomtest.NewUnprocessableInputError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewFormatNotSupportedError", code: errorcodes.SbomTest.FormatNotSupportedError, new: sbomtest.NewFormatNotSupportedError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewConversionFailedError", code: errorcodes.SbomTest.ConversionFailedError, new: sbomtest.NewConversionFailedError},
+	{namespace: "SbomTest", pkg: "sbomtest", function: "NewNoTestablePackagesError", code: errorcodes.SbomTest.NoTestablePackagesError, new: sbomtest.NewNoTestablePackagesError},
+	{namespace: "Fix", pkg: "fix", function: "NewFixScenarioNotSupportedError", code: errorcodes.Fix.FixScenarioNotSupportedError, new: fix.NewFixScenarioNotSupportedError},
+	{namespace: "Fix", pkg: "fix", function: "NewSCMRateLimitError", code: errorcodes.Fix.SCMRateLimitError, new: fix.NewSCMRateLimitError},
+	{namespace: "Fix", pkg: "fix", function: "NewUnauthorisedAccessError", code: errorcodes.Fix.UnauthorisedAccessError, new: fix.NewUnauthorisedAccessError},
+	{namespace: "Fix", pkg: "fix", function: "NewUnsupportedEcosystemError", code: errorcodes.Fix.UnsupportedEcosystemError, new: fix.NewUnsupportedEcosystemError},
+	{namespace: "Fix", pkg: "fix", function: "NewMetadataNotFoundError", code: errorcodes.Fix.MetadataNotFoundError, new: fix.NewMetadataNotFoundError},
+	{namespace: "Fix", pkg: "fix", function: "NewNoMatureVersionsFoundError", code: errorcodes.Fix.NoMatureVersionsFoundError, new: fix.NewNoMatureVersionsFoundError},
+	{namespace: "Fix", pkg: "fix", function: "NewVersionNotFoundError", code: errorcodes.Fix.VersionNotFoundError, new: fix.NewVersionNotFoundError},
+	{namespace: "Fix", pkg: "fix", function: "NewAlreadyLatestVersionError", code: errorcodes.Fix.AlreadyLatestVersionError, new: fix.NewAlreadyLatestVersionError},
+	{namespace: "Fix", pkg: "fix", function: "NewDowngradeVersionUnsupportedError", code: errorcodes.Fix.DowngradeVersionUnsupportedError, new: fix.NewDowngradeVersionUnsupportedError},
+	{namespace: "Fix", pkg: "fix", function: "NewVersionParsingError", code: errorcodes.Fix.VersionParsingError, new: fix.NewVersionParsingError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToGetPullRequestAttributesError", code: errorcodes.Fix.FailedToGetPullRequestAttributesError, new: fix.NewFailedToGetPullRequestAttributesError},
+	{namespace: "Fix", pkg: "fix", function: "NewPullRequestTemplateNotFoundError", code: errorcodes.Fix.PullRequestTemplateNotFoundError, new: fix.NewPullRequestTemplateNotFoundError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToCompilePrTemplateError", code: errorcodes.Fix.FailedToCompilePrTemplateError, new: fix.NewFailedToCompilePrTemplateError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToParsePullRequestAttributesError", code: errorcodes.Fix.FailedToParsePullRequestAttributesError, new: fix.NewFailedToParsePullRequestAttributesError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToLoadCompiledYamlError", code: errorcodes.Fix.FailedToLoadCompiledYamlError, new: fix.NewFailedToLoadCompiledYamlError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToGenerateHashError", code: errorcodes.Fix.FailedToGenerateHashError, new: fix.NewFailedToGenerateHashError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToCreatePRTemplateError", code: errorcodes.Fix.FailedToCreatePRTemplateError, new: fix.NewFailedToCreatePRTemplateError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToReadPRTemplateError", code: errorcodes.Fix.FailedToReadPRTemplateError, new: fix.NewFailedToReadPRTemplateError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToDeletePRTemplateError", code: errorcodes.Fix.FailedToDeletePRTemplateError, new: fix.NewFailedToDeletePRTemplateError},
+	{namespace: "Fix", pkg: "fix", function: "NewPRTemplateInvalidPayloadError", code: errorcodes.Fix.PRTemplateInvalidPayloadError, new: fix.NewPRTemplateInvalidPayloadError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToLoadCompiledJSONError", code: errorcodes.Fix.FailedToLoadCompiledJSONError, new: fix.NewFailedToLoadCompiledJSONError},
+	{namespace: "Fix", pkg: "fix", function: "NewFailedToRenderDefaultTemplateError", code: errorcodes.Fix.FailedToRenderDefaultTemplateError, new: fix.NewFailedToRenderDefaultTemplateError},
+	{namespace: "Code", pkg: "code", function: "NewAnalysisFileCountLimitExceededError", code: errorcodes.Code.AnalysisFileCountLimitExceededError, new: code.NewAnalysisFileCountLimitExceededError},
+	{namespace: "Code", pkg: "code", function: "NewAnalysisResultSizeLimitExceededError", code: errorcodes.Code.AnalysisResultSizeLimitExceededError, new: code.NewAnalysisResultSizeLimitExceededError},
+	{namespace: "Code", pkg: "code", function: "NewAnalysisTargetSizeLimitExceededError", code: errorcodes.Code.AnalysisTargetSizeLimitExceededError, new: code.NewAnalysisTargetSizeLimitExceededError},
+	{namespace: "Code", pkg: "code", function: "NewAnalysisFileNameLengthLimitExceededError", code: errorcodes.Code.AnalysisFileNameLengthLimitExceededError, new: code.NewAnalysisFileNameLengthLimitExceededError},
+	{namespace: "Code", pkg: "code", function: "NewFeatureIsNotEnabledError", code: errorcodes.Code.FeatureIsNotEnabledError, new: code.NewFeatureIsNotEnabledError},
+	{namespace: "Code", pkg: "code", function: "NewUnsupportedProjectError", code: errorcodes.Code.UnsupportedProjectError, new: code.NewUnsupportedProjectError},
+	{namespace: "Code", pkg: "code", function: "NewRuleExtensionAlreadyExistsForGroupError", code: errorcodes.Code.RuleExtensionAlreadyExistsForGroupError, new: code.NewRuleExtensionAlreadyExistsForGroupError},
+	{namespace: "Code", pkg: "code", function: "NewOrgRelationshipsMustBeUniqueError", code: errorcodes.Code.OrgRelationshipsMustBeUniqueError, new: code.NewOrgRelationshipsMustBeUniqueError},
+	{namespace: "Code", pkg: "code", function: "NewGroupRelationshipMustBeForAdminGroupError", code: errorcodes.Code.GroupRelationshipMustBeForAdminGroupError, new: code.NewGroupRelationshipMustBeForAdminGroupError},
+	{namespace: "Code", pkg: "code", function: "NewOrgOutsideAdminGroupError", code: errorcodes.Code.OrgOutsideAdminGroupError, new: code.NewOrgOutsideAdminGroupError},
+	{namespace: "Code", pkg: "code", function: "NewRuleExtensionsLimitReachedError", code: errorcodes.Code.RuleExtensionsLimitReachedError, new: code.NewRuleExtensionsLimitReachedError},
+	{namespace: "Code", pkg: "code", function: "NewTestRuleExtensionAlreadyPublishedForGroupError", code: errorcodes.Code.TestRuleExtensionAlreadyPublishedForGroupError, new: code.NewTestRuleExtensionAlreadyPublishedForGroupError},
+	{namespace: "Code", pkg: "code", function: "NewTestIDNotFoundError", code: errorcodes.Code.TestIDNotFoundError, new: code.NewTestIDNotFoundError},
+	{namespace: "Code", pkg: "code", function: "NewTestResultsExpiredError", code: errorcodes.Code.TestResultsExpiredError, new: code.NewTestResultsExpiredError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewFailedToReadManifestError", code: errorcodes.PRChecks.FailedToReadManifestError, new: prchecks.NewFailedToReadManifestError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewManifestNotFoundError", code: errorcodes.PRChecks.ManifestNotFoundError, new: prchecks.NewManifestNotFoundError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewThirdPartyRateLimitError", code: errorcodes.PRChecks.ThirdPartyRateLimitError, new: prchecks.NewThirdPartyRateLimitError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewOutOfSyncError", code: errorcodes.PRChecks.OutOfSyncError, new: prchecks.NewOutOfSyncError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewFailedDeterminingProjectTargetError", code: errorcodes.PRChecks.FailedDeterminingProjectTargetError, new: prchecks.NewFailedDeterminingProjectTargetError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewFailedToCompleteTestError", code: errorcodes.PRChecks.FailedToCompleteTestError, new: prchecks.NewFailedToCompleteTestError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewFailedToFetchMergeCommitShaError", code: errorcodes.PRChecks.FailedToFetchMergeCommitShaError, new: prchecks.NewFailedToFetchMergeCommitShaError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewMergeConflictError", code: errorcodes.PRChecks.MergeConflictError, new: prchecks.NewMergeConflictError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewFailedToDetectIssuesError", code: errorcodes.PRChecks.FailedToDetectIssuesError, new: prchecks.NewFailedToDetectIssuesError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewInvalidThirdPartyCredentialsError", code: errorcodes.PRChecks.InvalidThirdPartyCredentialsError, new: prchecks.NewInvalidThirdPartyCredentialsError},
+	{namespace: "PRChecks", pkg: "prchecks", function: "NewFailedToGenerateCommitStatusError", code: errorcodes.PRChecks.FailedToGenerateCommitStatusError, new: prchecks.NewFailedToGenerateCommitStatusError},
+	{namespace: "CLI", pkg: "cli", function: "NewGeneralCLIFailureError", code: errorcodes.CLI.GeneralCLIFailureError, new: cli.NewGeneralCLIFailureError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewConfigEnvironmentFailedError", code: errorcodes.CLI.ConfigEnvironmentFailedError, new: cli.NewConfigEnvironmentFailedError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewConfigEnvironmentConsistencyIssueError", code: errorcodes.CLI.ConfigEnvironmentConsistencyIssueError, new: cli.NewConfigEnvironmentConsistencyIssueError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewEmptyFlagOptionError", code: errorcodes.CLI.EmptyFlagOptionError, new: cli.NewEmptyFlagOptionError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewInvalidFlagOptionError", code: errorcodes.CLI.InvalidFlagOptionError, new: cli.NewInvalidFlagOptionError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewGetVulnsFromResourceFailedError", code: errorcodes.CLI.GetVulnsFromResourceFailedError, new: cli.NewGetVulnsFromResourceFailedError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewAuthConfigError", code: errorcodes.CLI.AuthConfigError, new: cli.NewAuthConfigError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewCommandArgsError", code: errorcodes.CLI.CommandArgsError, new: cli.NewCommandArgsError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewNoSupportedFilesFoundError", code: errorcodes.CLI.NoSupportedFilesFoundError, new: cli.NewNoSupportedFilesFoundError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewTooManyVulnerablePathsError", code: errorcodes.CLI.TooManyVulnerablePathsError, new: cli.NewTooManyVulnerablePathsError},
+	{namespace: "CLI", pkg: "cli", function: "NewValidationFailureError", code: errorcodes.CLI.ValidationFailureError, new: cli.NewValidationFailureError},
+	{namespace: "CLI", pkg: "cli", function: "NewGeneralSCAFailureError", code: errorcodes.CLI.GeneralSCAFailureError, new: cli.NewGeneralSCAFailureError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewGeneralIACFailureError", code: errorcodes.CLI.GeneralIACFailureError, new: cli.NewGeneralIACFailureError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewGeneralSASTFailureError", code: errorcodes.CLI.GeneralSASTFailureError, new: cli.NewGeneralSASTFailureError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewFeatureUnderDevelopmentError", code: errorcodes.CLI.FeatureUnderDevelopmentError, new: cli.NewFeatureUnderDevelopmentError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewCommandIsExperimentalError", code: errorcodes.CLI.CommandIsExperimentalError, new: cli.NewCommandIsExperimentalError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewFeatureNotEnabledError", code: errorcodes.CLI.FeatureNotEnabledError, new: cli.NewFeatureNotEnabledError},
+	{namespace: "CLI", pkg: "cli", function: "NewDNSResolutionError", code: errorcodes.CLI.DNSResolutionError, new: cli.NewDNSResolutionError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewNetworkTimeoutError", code: errorcodes.CLI.NetworkTimeoutError, new: cli.NewNetworkTimeoutError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewNetworkUnreachableError", code: errorcodes.CLI.NetworkUnreachableError, new: cli.NewNetworkUnreachableError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewTLSCertificateError", code: errorcodes.CLI.TLSCertificateError, new: cli.NewTLSCertificateError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewConnectionRefusedError", code: errorcodes.CLI.ConnectionRefusedError, new: cli.NewConnectionRefusedError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewGenericNetworkError", code: errorcodes.CLI.GenericNetworkError, new: cli.NewGenericNetworkError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewGeneralSecretsFailureError", code: errorcodes.CLI.GeneralSecretsFailureError, new: cli.NewGeneralSecretsFailureError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewDataRenderingError", code: errorcodes.CLI.DataRenderingError, new: cli.NewDataRenderingError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewTerminatedBySignalError", code: errorcodes.CLI.TerminatedBySignalError, new: cli.NewTerminatedBySignalError, nonHTTPStatus: true},
+	{namespace: "CLI", pkg: "cli", function: "NewConnectionTimeoutError", code: errorcodes.CLI.ConnectionTimeoutError, new: cli.NewConnectionTimeoutError, legacy: "Constructed by cli.NewConnectionTimeoutError, but numbered in the open source range. Renumbering it would break clients matching the published code."},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewVersioningSchemaDoesNotSupportTagError", code: errorcodes.CustomBaseImages.VersioningSchemaDoesNotSupportTagError, new: custombaseimages.NewVersioningSchemaDoesNotSupportTagError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewRequiredParameterNotProvidedError", code: errorcodes.CustomBaseImages.RequiredParameterNotProvidedError, new: custombaseimages.NewRequiredParameterNotProvidedError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewProjectDoesNotExistError", code: errorcodes.CustomBaseImages.ProjectDoesNotExistError, new: custombaseimages.NewProjectDoesNotExistError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewProjectIsNotContainerImageError", code: errorcodes.CustomBaseImages.ProjectIsNotContainerImageError, new: custombaseimages.NewProjectIsNotContainerImageError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewProjectDoesNotBelongToGroupError", code: errorcodes.CustomBaseImages.ProjectDoesNotBelongToGroupError, new: custombaseimages.NewProjectDoesNotBelongToGroupError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewRequestIdsDoNotMatchError", code: errorcodes.CustomBaseImages.RequestIdsDoNotMatchError, new: custombaseimages.NewRequestIdsDoNotMatchError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewRequestBodyAttributesMissingError", code: errorcodes.CustomBaseImages.RequestBodyAttributesMissingError, new: custombaseimages.NewRequestBodyAttributesMissingError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewInvalidPaginationCursorError", code: errorcodes.CustomBaseImages.InvalidPaginationCursorError, new: custombaseimages.NewInvalidPaginationCursorError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewUnableToSortByVersionError", code: errorcodes.CustomBaseImages.UnableToSortByVersionError, new: custombaseimages.NewUnableToSortByVersionError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewUpdateVersioningSchemaFailError", code: errorcodes.CustomBaseImages.UpdateVersioningSchemaFailError, new: custombaseimages.NewUpdateVersioningSchemaFailError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewProjectAlreadyLinkedError", code: errorcodes.CustomBaseImages.ProjectAlreadyLinkedError, new: custombaseimages.NewProjectAlreadyLinkedError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewVersioningSchemaMissingError", code: errorcodes.CustomBaseImages.VersioningSchemaMissingError, new: custombaseimages.NewVersioningSchemaMissingError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewVersioningSchemaInapplicableError", code: errorcodes.CustomBaseImages.VersioningSchemaInapplicableError, new: custombaseimages.NewVersioningSchemaInapplicableError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewImageNotFoundError", code: errorcodes.CustomBaseImages.ImageNotFoundError, new: custombaseimages.NewImageNotFoundError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewImageDoesNotExistError", code: errorcodes.CustomBaseImages.ImageDoesNotExistError, new: custombaseimages.NewImageDoesNotExistError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewImageUpdateFailedError", code: errorcodes.CustomBaseImages.ImageUpdateFailedError, new: custombaseimages.NewImageUpdateFailedError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewPropertiesRetrievalFailedError", code: errorcodes.CustomBaseImages.PropertiesRetrievalFailedError, new: custombaseimages.NewPropertiesRetrievalFailedError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewImageCollectionRetrievalFailedError", code: errorcodes.CustomBaseImages.ImageCollectionRetrievalFailedError, new: custombaseimages.NewImageCollectionRetrievalFailedError},
+	{namespace: "CustomBaseImages", pkg: "custombaseimages", function: "NewCreateVersioningSchemaFailError", code: errorcodes.CustomBaseImages.CreateVersioningSchemaFailError, new: custombaseimages.NewCreateVersioningSchemaFailError},
+	{namespace: "Integration", pkg: "integration", function: "NewIntegrationNotFoundError", code: errorcodes.Integration.IntegrationNotFoundError, new: integration.NewIntegrationNotFoundError},
+	{namespace: "Target", pkg: "target", function: "NewTargetNotFoundError", code: errorcodes.Target.TargetNotFoundError, new: target.NewTargetNotFoundError},
+	{namespace: "Target", pkg: "target", function: "NewNoUniqueTargetFoundError", code: errorcodes.Target.NoUniqueTargetFoundError, new: target.NewNoUniqueTargetFoundError},
+	{namespace: "SCM", pkg: "scm", function: "NewUnsupportedIntegrationTypeError", code: errorcodes.SCM.UnsupportedIntegrationTypeError, new: scm.NewUnsupportedIntegrationTypeError},
+	{namespace: "SCM", pkg: "scm", function: "NewRevisionNotResolvedError", code: errorcodes.SCM.RevisionNotResolvedError, new: scm.NewRevisionNotResolvedError},
+	{namespace: "SCM", pkg: "scm", function: "NewIntegrationAuthenticationFailedError", code: errorcodes.SCM.IntegrationAuthenticationFailedError, new: scm.NewIntegrationAuthenticationFailedError},
+	{namespace: "SCM", pkg: "scm", function: "NewIntegrationAuthorizationFailedError", code: errorcodes.SCM.IntegrationAuthorizationFailedError, new: scm.NewIntegrationAuthorizationFailedError},
+	{namespace: "SCM", pkg: "scm", function: "NewFilesLimitExceededError", code: errorcodes.SCM.FilesLimitExceededError, new: scm.NewFilesLimitExceededError},
+	{namespace: "SCM", pkg: "scm", function: "NewSizeLimitExceededError", code: errorcodes.SCM.SizeLimitExceededError, new: scm.NewSizeLimitExceededError},
+	{namespace: "SCM", pkg: "scm", function: "NewResourceNotFoundError", code: errorcodes.SCM.ResourceNotFoundError, new: scm.NewResourceNotFoundError},
+	{namespace: "Policies", pkg: "policies", function: "NewInvalidPolicyApplyError", code: errorcodes.Policies.InvalidPolicyApplyError, new: policies.NewInvalidPolicyApplyError},
+	{namespace: "AiBom", pkg: "aibom", function: "NewInternalError", code: errorcodes.AiBom.InternalError, new: aibom.NewInternalError},
+	{namespace: "AiBom", pkg: "aibom", function: "NewForbiddenError", code: errorcodes.AiBom.ForbiddenError, new: aibom.NewForbiddenError},
+	{namespace: "AiBom", pkg: "aibom", function: "NewNoSupportedFilesError", code: errorcodes.AiBom.NoSupportedFilesError, new: aibom.NewNoSupportedFilesError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewUploadRevisionNotFoundError", code: errorcodes.UploadRevision.UploadRevisionNotFoundError, new: uploadrevision.NewUploadRevisionNotFoundError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewUploadRevisionSealedError", code: errorcodes.UploadRevision.UploadRevisionSealedError, new: uploadrevision.NewUploadRevisionSealedError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewFileTooLargeError", code: errorcodes.UploadRevision.FileTooLargeError, new: uploadrevision.NewFileTooLargeError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewTotalFilesSizeLimitExceededError", code: errorcodes.UploadRevision.TotalFilesSizeLimitExceededError, new: uploadrevision.NewTotalFilesSizeLimitExceededError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewFileCountLimitExceededError", code: errorcodes.UploadRevision.FileCountLimitExceededError, new: uploadrevision.NewFileCountLimitExceededError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewFilePathTooLongError", code: errorcodes.UploadRevision.FilePathTooLongError, new: uploadrevision.NewFilePathTooLongError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewPopulateRequestLimitExceededError", code: errorcodes.UploadRevision.PopulateRequestLimitExceededError, new: uploadrevision.NewPopulateRequestLimitExceededError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewTotalUploadRevisionFileCountLimitExceededError", code: errorcodes.UploadRevision.TotalUploadRevisionFileCountLimitExceededError, new: uploadrevision.NewTotalUploadRevisionFileCountLimitExceededError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewTotalUploadRevisionSizeLimitExceededError", code: errorcodes.UploadRevision.TotalUploadRevisionSizeLimitExceededError, new: uploadrevision.NewTotalUploadRevisionSizeLimitExceededError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewUploadRevisionIdMismatchError", code: errorcodes.UploadRevision.UploadRevisionIdMismatchError, new: uploadrevision.NewUploadRevisionIdMismatchError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewMultipartFieldNameMissingError", code: errorcodes.UploadRevision.MultipartFieldNameMissingError, new: uploadrevision.NewMultipartFieldNameMissingError},
+	{namespace: "UploadRevision", pkg: "uploadrevision", function: "NewUploadRevisionUnsealedError", code: errorcodes.UploadRevision.UploadRevisionUnsealedError, new: uploadrevision.NewUploadRevisionUnsealedError},
+}
@@ -0,0 +1,195 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+)
+
+// NonHTTPStatus is the status code of errors which are not tied to an HTTP
+// response, for example errors raised locally by the CLI.
+const NonHTTPStatus = 0
+
+var (
+	knownLevels          = map[string]bool{"error": true, "warn": true, "fatal": true}
+	knownClassifications = map[string]bool{"ACTIONABLE": true, "UNEXPECTED": true, "UNSUPPORTED": true}
+)
+
+// packagePrefixes are the code prefixes, see errorcodes.ParsedCode.Prefix,
+// which the codes of a package may use, keyed by package path relative to the
+// module root.
+var packagePrefixes = map[string][]string{
+	"aibom":                          {"SNYK-AIBOM"},
+	"cli":                            {"SNYK-CLI"},
+	"code":                           {"SNYK-CODE"},
+	"custombaseimages":               {"SNYK-CBI"},
+	"fix":                            {"PR-FAILURES", "SNYK-PACKAGES", "SNYK-PR-TEMPLATE"},
+	"integration":                    {"SNYK-INTEGRATION"},
+	"isolatedbuilds":                 {"SNYK-OS"},
+	"openapi":                        {"SNYK-OPENAPI"},
+	"opensource/ecosystems":          {"SNYK-OS"},
+	"opensource/ecosystems/dotnet":   {"SNYK-OS-DOTNET"},
+	"opensource/ecosystems/golang":   {"SNYK-OS-GO"},
+	"opensource/ecosystems/maven":    {"SNYK-OS-MAVEN"},
+	"opensource/ecosystems/nodejs":   {"SNYK-OS-NODEJS"},
+	"opensource/ecosystems/python":   {"SNYK-OS-PYTHON"},
+	"opensource/ecosystems/ruby":     {"SNYK-OS-RUBY"},
+	"opensource/ecosystems/settings": {"SNYK-OS-SETTINGS"},
+	"opensource/ecosystems/uv":       {"SNYK-OS-UV"},
+	"opensource/project/issues":      {"SNYK-OSSI-OSPI"},
+	"opensource/project/snapshots":   {"SNYK-OSSI-OSPSS"},
+	"opensource/unmanaged":           {"SNYK-OSJVM"},
+	"policies":                       {"SNYK-POLICY"},
+	"prchecks":                       {"SNYK-PR-CHECK"},
+	"purl/vulnerabilities":           {"SNYK-OSSI"},
+	"sbomexport":                     {"SNYK-OS"},
+	"sbomtest":                       {"SNYK-SBOM"},
+	"scm":                            {"SNYK-SCM"},
+	"snyk":                           {"SNYK"},
+	"target":                         {"SNYK-TARGET"},
+	"uploadrevision":                 {"SNYK-UPLOAD-REVISION"},
+}
+
+// Problem is an inconsistency of a catalog constructor reported by Verify.
+type Problem struct {
+	Namespace string
+	Function  string
+	Code      string
+	Message   string
+}
+
+func (p Problem) Error() string {
+	return fmt.Sprintf("%s.%s (%s): %s", p.Namespace, p.Function, p.Code, p.Message)
+}
+
+// Verify checks the consistency of every constructor of the catalog. It
+// returns nil, or the found problems joined into a single error whose Problem
+// values can be retrieved with errors.As or by unwrapping it.
+//
+// The checks are:
+//   - the Type anchor equals the lowercased error code
+//   - the error code is the one declared in the errorcodes package
+//   - the error code is well formed, see errorcodes.ParseCode, with a four
+//     digit ID
+//   - the prefix of the error code is one of the prefixes of its package
+//   - error codes are unique across all namespaces
+//   - the status code is a known HTTP status code, or NonHTTPStatus for the
+//     errors declared with nonHTTPStatus in the spec, and only for those
+//   - the level and classification are known values
+//   - links are absolute http or https URLs
+//   - replacements of deprecated codes are in the catalog
+//
+// A few published codes predate the format and prefix checks. They are
+// declared legacy in the spec, with the reason, and exempt from them.
+func Verify() error {
+	return verify(registry, packagePrefixes)
+}
+
+func verify(constructors []constructor, prefixes map[string][]string) error {
+	var problems []error
+	functions := make(map[string]string, len(constructors))
+	codes := make(map[string]bool, len(constructors))
+	for _, c := range constructors {
+		codes[c.code] = true
+	}
+
+	for _, c := range constructors {
+		err := c.new("")
+
+		report := func(format string, args ...any) {
+			problems = append(problems, Problem{
+				Namespace: c.namespace,
+				Function:  c.function,
+				Code:      err.ErrorCode,
+				Message:   fmt.Sprintf(format, args...),
+			})
+		}
+
+		if err.ErrorCode != c.code {
+			report("error code differs from %q declared in errorcodes", c.code)
+		}
+
+		if c.legacy == "" {
+			if parsed, parseErr := errorcodes.ParseCode(err.ErrorCode); parseErr != nil {
+				report("%v", parseErr)
+			} else {
+				if parsed.String() != fmt.Sprintf("%s-%04d", parsed.Prefix(), parsed.ID) {
+					report("error code ID is not four digits")
+				}
+
+				if !contains(prefixes[c.pkg], parsed.Prefix()) {
+					report("prefix %s is not one of %v declared for package %s", parsed.Prefix(), prefixes[c.pkg], c.pkg)
+				}
+			}
+		}
+
+		if other, ok := functions[err.ErrorCode]; ok {
+			report("error code is also used by %s", other)
+		} else {
+			functions[err.ErrorCode] = c.namespace + "." + c.function
+		}
+
+		if u, parseErr := url.Parse(err.Type); parseErr != nil || u.Fragment != strings.ToLower(err.ErrorCode) {
+			report("type %q is not anchored at %q", err.Type, strings.ToLower(err.ErrorCode))
+		}
+
+		switch {
+		case c.nonHTTPStatus && err.StatusCode != NonHTTPStatus:
+			report("status code %d of an error declared non-HTTP", err.StatusCode)
+		case !c.nonHTTPStatus && err.StatusCode == NonHTTPStatus:
+			report("status code %d is not declared with nonHTTPStatus in the spec", err.StatusCode)
+		case !c.nonHTTPStatus && http.StatusText(err.StatusCode) == "":
+			report("unknown HTTP status code %d", err.StatusCode)
+		}
+
+		if !knownLevels[err.Level] {
+			report("unknown level %q", err.Level)
+		}
+
+		if !knownClassifications[err.Classification] {
+			report("unknown classification %q", err.Classification)
+		}
+
+		for _, link := range err.Links {
+			if u, parseErr := url.Parse(link); parseErr != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				report("link %q is not an absolute http(s) URL", link)
+			}
+		}
+
+		if c.deprecated != nil && c.deprecated.ReplacedBy != "" && !codes[c.deprecated.ReplacedBy] {
+			report("replacement %s is not in the catalog", c.deprecated.ReplacedBy)
+		}
+	}
+
+	return errors.Join(problems...)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,169 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package catalog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestVerify(t *testing.T) {
+	require.NoError(t, Verify())
+}
+
+var acmePrefixes = map[string][]string{"acme": {"ACME"}}
+
+func TestVerify_Problems(t *testing.T) {
+	valid := snyk_errors.Error{
+		Type:           "https://docs.snyk.io/scan-with-snyk/error-catalog#acme-0001",
+		ErrorCode:      "ACME-0001",
+		StatusCode:     400,
+		Classification: "ACTIONABLE",
+		Level:          "error",
+		Links:          []string{"https://docs.snyk.io"},
+	}
+
+	type test struct {
+		description   string
+		code          string
+		modify        func(e *snyk_errors.Error)
+		deprecated    *Deprecation
+		nonHTTPStatus bool
+		message       string
+	}
+
+	tests := []test{
+		{description: "valid"},
+		{description: "non-HTTP status", modify: func(e *snyk_errors.Error) { e.StatusCode = NonHTTPStatus }, nonHTTPStatus: true},
+		{description: "undeclared non-HTTP status", modify: func(e *snyk_errors.Error) { e.StatusCode = NonHTTPStatus }, message: "status code 0 is not declared with nonHTTPStatus"},
+		{description: "HTTP status declared non-HTTP", nonHTTPStatus: true, message: "status code 400 of an error declared non-HTTP"},
+		{description: "code not in errorcodes", code: "ACME-0002", message: `error code differs from "ACME-0002" declared in errorcodes`},
+		{description: "anchor mismatch", modify: func(e *snyk_errors.Error) { e.Type = "https://docs.snyk.io/scan-with-snyk/error-catalog#acme-0002" }, message: "is not anchored at \"acme-0001\""},
+		{description: "missing anchor", modify: func(e *snyk_errors.Error) { e.Type = "" }, message: "is not anchored"},
+		{description: "unknown status", modify: func(e *snyk_errors.Error) { e.StatusCode = 4222 }, message: "unknown HTTP status code 4222"},
+		{description: "unknown level", modify: func(e *snyk_errors.Error) { e.Level = "warning" }, message: `unknown level "warning"`},
+		{description: "unknown classification", modify: func(e *snyk_errors.Error) { e.Classification = "actionable" }, message: `unknown classification "actionable"`},
+		{description: "relative link", modify: func(e *snyk_errors.Error) { e.Links = []string{"/docs"} }, message: `link "/docs" is not`},
+		{description: "deprecated", deprecated: &Deprecation{Since: "1.0.0", ReplacedBy: "ACME-0001"}},
+		{description: "unknown replacement", deprecated: &Deprecation{Since: "1.0.0", ReplacedBy: "ACME-0002"}, message: "replacement ACME-0002 is not in the catalog"},
+		{description: "unparseable link", modify: func(e *snyk_errors.Error) { e.Links = []string{"https://exa mple.com/%zz"} }, message: "is not an absolute http(s) URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			c := constructor{
+				namespace:     "Acme",
+				pkg:           "acme",
+				function:      "NewBrokenError",
+				code:          "ACME-0001",
+				deprecated:    tt.deprecated,
+				nonHTTPStatus: tt.nonHTTPStatus,
+				new: func(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+					e := valid
+					if tt.modify != nil {
+						tt.modify(&e)
+					}
+					return e
+				},
+			}
+			if tt.code != "" {
+				c.code = tt.code
+			}
+
+			err := verify([]constructor{c}, acmePrefixes)
+			if tt.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var problem Problem
+			require.True(t, errors.As(err, &problem))
+			require.Equal(t, "Acme", problem.Namespace)
+			require.Equal(t, "NewBrokenError", problem.Function)
+			require.Equal(t, "ACME-0001", problem.Code)
+			require.Contains(t, problem.Message, tt.message)
+		})
+	}
+}
+
+func TestVerify_DuplicateCodes(t *testing.T) {
+	newError := func(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+		return snyk_errors.Error{
+			Type:           "https://docs.snyk.io/scan-with-snyk/error-catalog#acme-0001",
+			ErrorCode:      "ACME-0001",
+			StatusCode:     400,
+			Classification: "ACTIONABLE",
+			Level:          "error",
+		}
+	}
+
+	err := verify([]constructor{
+		{namespace: "Acme", pkg: "acme", function: "NewBrokenError", code: "ACME-0001", new: newError},
+		{namespace: "Other", pkg: "acme", function: "NewAlsoBrokenError", code: "ACME-0001", new: newError},
+	}, acmePrefixes)
+
+	require.EqualError(t, err, "Other.NewAlsoBrokenError (ACME-0001): error code is also used by Acme.NewBrokenError")
+}
+
+func TestVerify_CodeFormat(t *testing.T) {
+	type test struct {
+		description string
+		code        string
+		legacy      string
+		message     string
+	}
+
+	tests := []test{
+		{description: "valid", code: "ACME-0001"},
+		{description: "malformed", code: "Acme-0001", message: `invalid error code "Acme-0001"`},
+		{description: "three digits", code: "ACME-001", message: "error code ID is not four digits"},
+		{description: "prefix of another package", code: "OTHER-0001", message: "prefix OTHER is not one of [ACME] declared for package acme"},
+		{description: "legacy code", code: "SNYK-OSJVM-001", legacy: "Numbered with three digits."},
+		{description: "undeclared legacy code", code: "SNYK-OSJVM-001", message: "error code ID is not four digits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := verify([]constructor{{
+				namespace: "Acme",
+				pkg:       "acme",
+				function:  "NewBrokenError",
+				code:      tt.code,
+				legacy:    tt.legacy,
+				new: func(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+					return snyk_errors.Error{
+						Type:           "https://docs.snyk.io/scan-with-snyk/error-catalog#" + strings.ToLower(tt.code),
+						ErrorCode:      tt.code,
+						StatusCode:     400,
+						Classification: "ACTIONABLE",
+						Level:          "error",
+					}
+				},
+			}}, acmePrefixes)
+			if tt.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tt.message)
+		})
+	}
+}
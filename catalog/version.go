@@ -0,0 +1,54 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package catalog
+
+import (
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// Version is the version of this catalog: the semantic version of the spec
+// with a hash of its content as build metadata, see
+// snyk_errors.CatalogVersion.
+const Version = snyk_errors.CatalogVersion
+
+// PeerVersion returns the catalog version a decoded error was encoded with,
+// or an empty string if the peer did not stamp it.
+func PeerVersion(e snyk_errors.Error) string {
+	version, _ := e.Meta[snyk_errors.MetaKeyCatalogVersion].(string)
+	return version
+}
+
+// WarnUnknown returns an option calling warn for decoded Error Catalog errors
+// with a code unknown to this catalog, which usually means that the peer uses
+// a newer catalog. peerVersion is the catalog version of the peer, if known.
+// Errors which do not originate from the Error Catalog are ignored.
+//
+//	errs, err := snyk_errors.FromJSONAPIErrorBytes(data, catalog.WarnUnknown(func(code, peerVersion string) {
+//		logger.Warn("unknown error code", "code", code, "peerCatalogVersion", peerVersion)
+//	}))
+func WarnUnknown(warn func(code, peerVersion string)) snyk_errors.Option {
+	return func(e *snyk_errors.Error) {
+		if e.ErrorCode == "" || e.Meta["isErrorCatalogError"] != true {
+			return
+		}
+
+		if _, ok := Lookup(e.ErrorCode); ok {
+			return
+		}
+
+		warn(e.ErrorCode, PeerVersion(*e))
+	}
+}
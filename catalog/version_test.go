@@ -0,0 +1,66 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package catalog_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestVersion(t *testing.T) {
+	require.Regexp(t, `^\d+\.\d+\.\d+\+[0-9a-f]{12}$`, catalog.Version)
+
+	var buf bytes.Buffer
+	require.NoError(t, snyk.NewBadRequestError("").MarshalToJSONAPIError(&buf, ""))
+
+	errs, err := snyk_errors.FromJSONAPIErrorBytes(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, catalog.Version, catalog.PeerVersion(errs[0]))
+
+	require.Empty(t, catalog.PeerVersion(snyk.NewBadRequestError("")))
+}
+
+func TestWarnUnknown(t *testing.T) {
+	data := []byte(`{"jsonapi":{"version":"1.0"},"errors":[
+		{"code":"SNYK-0003","meta":{"isErrorCatalogError":true,"catalogVersion":"1.0.0+000000000000"}},
+		{"code":"SNYK-9997","meta":{"isErrorCatalogError":true,"catalogVersion":"1.1.0+000000000000"}},
+		{"code":"SNYK-9998","meta":{"isErrorCatalogError":true}},
+		{"code":"SNYK-OS-GO-0003","meta":{"isErrorCatalogError":true}},
+		{"code":"E-1234"}
+	]}`)
+
+	type warning struct {
+		code, peerVersion string
+	}
+
+	var warnings []warning
+	errs, err := snyk_errors.FromJSONAPIErrorBytes(data, catalog.WarnUnknown(func(code, peerVersion string) {
+		warnings = append(warnings, warning{code, peerVersion})
+	}))
+	require.NoError(t, err)
+	require.Len(t, errs, 5)
+
+	require.Equal(t, []warning{
+		{"SNYK-9997", "1.1.0+000000000000"},
+		{"SNYK-9998", ""},
+	}, warnings)
+}
@@ -0,0 +1,106 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// FromNetError classifies a network error by walking its chain and returns
+// the matching catalog error, with err as cause and its message as detail.
+// The checks go from the most to the least specific:
+//
+//   - certificate verification failures: NewTLSCertificateError
+//   - *net.DNSError: NewDNSResolutionError
+//   - syscall.ECONNREFUSED: NewConnectionRefusedError
+//   - syscall.ENETUNREACH and syscall.EHOSTUNREACH: NewNetworkUnreachableError
+//   - os.ErrDeadlineExceeded, context.DeadlineExceeded and other timeouts:
+//     NewNetworkTimeoutError
+//   - any other net.Error: NewGenericNetworkError
+//
+// It returns false if err is not a network error. The options are applied
+// after the cause is set.
+func FromNetError(err error, options ...snyk_errors.Option) (snyk_errors.Error, bool) {
+	if err == nil {
+		return snyk_errors.Error{}, false
+	}
+
+	var constructor func(detail string, options ...snyk_errors.Option) snyk_errors.Error
+
+	switch {
+	case isCertificateError(err):
+		constructor = NewTLSCertificateError
+	case isDNSError(err):
+		constructor = NewDNSResolutionError
+	case errors.Is(err, syscall.ECONNREFUSED):
+		constructor = NewConnectionRefusedError
+	case errors.Is(err, syscall.ENETUNREACH), errors.Is(err, syscall.EHOSTUNREACH):
+		constructor = NewNetworkUnreachableError
+	case isTimeout(err):
+		constructor = NewNetworkTimeoutError
+	case isNetError(err):
+		constructor = NewGenericNetworkError
+	default:
+		return snyk_errors.Error{}, false
+	}
+
+	options = append([]snyk_errors.Option{snyk_errors.WithCause(err)}, options...)
+
+	return constructor(err.Error(), options...), true
+}
+
+func isCertificateError(err error) bool {
+	var (
+		verification *tls.CertificateVerificationError
+		unknown      x509.UnknownAuthorityError
+		invalid      x509.CertificateInvalidError
+		hostname     x509.HostnameError
+		system       x509.SystemRootsError
+	)
+
+	return errors.As(err, &verification) ||
+		errors.As(err, &unknown) ||
+		errors.As(err, &invalid) ||
+		errors.As(err, &hostname) ||
+		errors.As(err, &system)
+}
+
+func isDNSError(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+func isTimeout(err error) bool {
+	if errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func isNetError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,188 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cli_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/cli"
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// closedAddress returns the address of a local listener which has been
+// closed again, so that connecting to it is refused.
+func closedAddress(t *testing.T, network string) string {
+	t.Helper()
+
+	if network == "udp" {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+		return conn.LocalAddr().String()
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	return listener.Addr().String()
+}
+
+func TestFromNetError_LocalListeners(t *testing.T) {
+	type test struct {
+		description string
+		err         func(t *testing.T) error
+		code        string
+	}
+
+	tests := []test{
+		{
+			description: "connection refused",
+			err: func(t *testing.T) error {
+				_, err := net.Dial("tcp", closedAddress(t, "tcp"))
+				return err
+			},
+			code: errorcodes.CLI.ConnectionRefusedError,
+		},
+		{
+			description: "read deadline",
+			err: func(t *testing.T) error {
+				listener, err := net.Listen("tcp", "127.0.0.1:0")
+				require.NoError(t, err)
+				t.Cleanup(func() { listener.Close() })
+
+				conn, err := net.Dial("tcp", listener.Addr().String())
+				require.NoError(t, err)
+				t.Cleanup(func() { conn.Close() })
+
+				require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Millisecond)))
+				_, err = conn.Read(make([]byte, 1))
+				return err
+			},
+			code: errorcodes.CLI.NetworkTimeoutError,
+		},
+		{
+			description: "request context deadline",
+			err: func(t *testing.T) error {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					<-r.Context().Done()
+				}))
+				t.Cleanup(server.Close)
+
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				defer cancel()
+
+				req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+				require.NoError(t, err)
+
+				_, err = server.Client().Do(req)
+				return err
+			},
+			code: errorcodes.CLI.NetworkTimeoutError,
+		},
+		{
+			description: "untrusted certificate",
+			err: func(t *testing.T) error {
+				server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+				t.Cleanup(server.Close)
+
+				_, err := http.Get(server.URL)
+				return err
+			},
+			code: errorcodes.CLI.TLSCertificateError,
+		},
+		{
+			description: "DNS server refusing queries",
+			err: func(t *testing.T) error {
+				dnsServer := closedAddress(t, "udp")
+				resolver := &net.Resolver{
+					PreferGo: true,
+					Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+						return (&net.Dialer{}).DialContext(ctx, "udp", dnsServer)
+					},
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				_, err := resolver.LookupHost(ctx, "api.snyk.example")
+				return err
+			},
+			code: errorcodes.CLI.DNSResolutionError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := tt.err(t)
+			require.Error(t, err)
+
+			actual, ok := cli.FromNetError(err)
+			require.True(t, ok, "%T: %v", err, err)
+			require.Equal(t, tt.code, actual.ErrorCode, "%T: %v", err, err)
+			require.Equal(t, err.Error(), actual.Detail)
+			require.Equal(t, err, actual.Cause)
+		})
+	}
+}
+
+func TestFromNetError(t *testing.T) {
+	unreachable := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ENETUNREACH)}
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+
+	type test struct {
+		description string
+		err         error
+		code        string
+	}
+
+	tests := []test{
+		{description: "network unreachable", err: unreachable, code: errorcodes.CLI.NetworkUnreachableError},
+		{description: "host unreachable", err: fmt.Errorf("connecting: %w", syscall.EHOSTUNREACH), code: errorcodes.CLI.NetworkUnreachableError},
+		{description: "DNS error", err: fmt.Errorf("resolving: %w", &net.DNSError{Err: "no such host", Name: "api.snyk.example", IsNotFound: true}), code: errorcodes.CLI.DNSResolutionError},
+		{description: "context deadline", err: fmt.Errorf("uploading: %w", context.DeadlineExceeded), code: errorcodes.CLI.NetworkTimeoutError},
+		{description: "other network error", err: reset, code: errorcodes.CLI.GenericNetworkError},
+		{description: "not a network error", err: errors.New("parse error")},
+		{description: "nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, ok := cli.FromNetError(tt.err)
+			require.Equal(t, tt.code != "", ok)
+			require.Equal(t, tt.code, actual.ErrorCode)
+		})
+	}
+}
+
+func TestFromNetError_Options(t *testing.T) {
+	actual, ok := cli.FromNetError(syscall.ECONNREFUSED, snyk_errors.WithMeta("url", "https://api.snyk.io"))
+	require.True(t, ok)
+	require.Equal(t, "https://api.snyk.io", actual.Meta["url"])
+	require.ErrorIs(t, actual, syscall.ECONNREFUSED)
+}
@@ -0,0 +1,247 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/render"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func lookup(args []string, _ io.Reader, stdout io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("expected exactly one error code")
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(args[0]))
+
+	entry, ok := catalog.Lookup(code)
+	if !ok {
+		return fmt.Errorf("unknown error code %q", args[0])
+	}
+
+	if entry.Code != code {
+		for _, deprecated := range catalog.All() {
+			if deprecated.Code == code {
+				fmt.Fprintf(stdout, "%s is deprecated since %s and replaced by %s.\n\n", code, deprecated.Deprecated.Since, entry.Code)
+			}
+		}
+	}
+
+	printEntry(stdout, entry)
+	return nil
+}
+
+func list(args []string, _ io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	namespace := flags.String("namespace", "", "only list entries of this namespace")
+	classification := flags.String("classification", "", "only list entries with this classification")
+	level := flags.String("level", "", "only list entries with this level")
+
+	if err := parseFlags(flags, "", args, stdout); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	var entries []catalog.Entry
+	for _, entry := range catalog.All() {
+		if matches(entry.Namespace, *namespace) && matches(entry.Classification, *classification) && matches(entry.Level, *level) {
+			entries = append(entries, entry)
+		}
+	}
+
+	printTable(stdout, entries)
+	return nil
+}
+
+func search(args []string, _ io.Reader, stdout io.Writer) error {
+	terms := strings.Fields(strings.ToLower(strings.Join(args, " ")))
+	if len(terms) == 0 {
+		return errors.New("expected a search query")
+	}
+
+	var inTitle, inDescription []catalog.Entry
+	for _, entry := range catalog.All() {
+		title := strings.ToLower(entry.Title)
+		text := title + " " + strings.ToLower(entry.Description)
+
+		switch {
+		case containsAll(title, terms):
+			inTitle = append(inTitle, entry)
+		case containsAll(text, terms):
+			inDescription = append(inDescription, entry)
+		}
+	}
+
+	printTable(stdout, append(inTitle, inDescription...))
+	return nil
+}
+
+func export(args []string, _ io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "json", "export format: json, yaml, csv or markdown")
+
+	if err := parseFlags(flags, "", args, stdout); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	entries := catalog.All()
+
+	switch *format {
+	case "json":
+		return catalog.EncodeJSON(stdout, entries)
+	case "yaml":
+		return catalog.EncodeYAML(stdout, entries)
+	case "csv":
+		return encodeCSV(stdout, entries)
+	case "markdown":
+		return encodeMarkdown(stdout, entries)
+	default:
+		return fmt.Errorf("unsupported format %q", *format)
+	}
+}
+
+func decode(_ []string, stdin io.Reader, stdout io.Writer) error {
+	data, err := io.ReadAll(stdin)
+	if err != nil {
+		return err
+	}
+
+	errs, err := snyk_errors.FromJSONAPIErrorBytes(data)
+	if err != nil {
+		return fmt.Errorf("invalid JSON:API error document: %w", err)
+	}
+
+	for i := range errs {
+		errs[i] = enrich(errs[i])
+	}
+
+	color := false
+	if f, ok := stdout.(*os.File); ok {
+		color = render.ColorEnabled(f)
+	}
+
+	return render.New(render.WithColor(color)).RenderAll(stdout, errs)
+}
+
+// enrich fills in the description and links of a decoded error, which are not
+// part of JSON:API error documents, from the catalog entry of its code.
+// Deprecated codes keep their own entry, so that the error is not mixed with
+// the one of its replacement.
+func enrich(err snyk_errors.Error) snyk_errors.Error {
+	entry, ok := catalog.LookupExact(err.ErrorCode)
+	if !ok {
+		return err
+	}
+
+	if err.Description == "" {
+		err.Description = entry.Description
+	}
+
+	if len(err.Links) == 0 {
+		err.Links = entry.Links
+	}
+
+	if err.Type == "" {
+		err.Type = entry.Type
+	}
+
+	return err
+}
+
+// parseFlags parses the flags of a command which takes the given positional
+// arguments. Parse errors are returned without
+// printing anything, so that run reports them once. On -h or --help the flags
+// are printed to stdout and flag.ErrHelp is returned, which run treats as
+// success.
+func parseFlags(flags *flag.FlagSet, arguments string, args []string, stdout io.Writer) error {
+	flags.SetOutput(io.Discard)
+
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		flags.SetOutput(stdout)
+		fmt.Fprintf(stdout, "Usage: errcatalog %s [flags]%s\n\nFlags:\n", flags.Name(), arguments)
+		flags.PrintDefaults()
+	}
+
+	return err
+}
+
+func printEntry(w io.Writer, entry catalog.Entry) {
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+
+	fmt.Fprintf(tw, "Code:\t%s\n", entry.Code)
+	fmt.Fprintf(tw, "Title:\t%s\n", entry.Title)
+	fmt.Fprintf(tw, "Namespace:\t%s\n", entry.Namespace)
+	fmt.Fprintf(tw, "Constructor:\t%s.%s\n", entry.Package, entry.Function)
+	fmt.Fprintf(tw, "Status code:\t%d\n", entry.StatusCode)
+	fmt.Fprintf(tw, "Classification:\t%s\n", entry.Classification)
+	fmt.Fprintf(tw, "Level:\t%s\n", entry.Level)
+	fmt.Fprintf(tw, "Documentation:\t%s\n", entry.Type)
+	if entry.Deprecated != nil {
+		fmt.Fprintf(tw, "Deprecated:\tsince %s. %s\n", entry.Deprecated.Since, entry.Deprecated.Notice)
+	}
+	for i, link := range entry.Links {
+		label := ""
+		if i == 0 {
+			label = "Links:"
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", label, link)
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\n%s\n", entry.Description)
+}
+
+func printTable(w io.Writer, entries []catalog.Entry) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "CODE\tNAMESPACE\tLEVEL\tCLASSIFICATION\tTITLE")
+	for _, entry := range entries {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", entry.Code, entry.Namespace, entry.Level, entry.Classification, entry.Title)
+	}
+
+	tw.Flush()
+}
+
+func matches(value, filter string) bool {
+	return filter == "" || strings.EqualFold(value, filter)
+}
+
+func containsAll(text string, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+
+	return true
+}
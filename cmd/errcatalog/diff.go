@@ -0,0 +1,113 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+)
+
+// diff compares two catalog exports, or an export with the catalog of this
+// build, and fails if there are breaking changes.
+func diff(args []string, _ io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	format := flags.String("format", "text", "output format: text or json")
+
+	if err := parseFlags(flags, " <old> [new]", args, stdout); err != nil {
+		return err
+	}
+
+	if flags.NArg() < 1 || flags.NArg() > 2 {
+		return errors.New("expected an old export and optionally a new export")
+	}
+
+	old, err := readExport(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	current := catalog.All()
+	if flags.NArg() == 2 {
+		if current, err = readExport(flags.Arg(1)); err != nil {
+			return err
+		}
+	}
+
+	changes := catalog.Compare(old, current)
+
+	switch *format {
+	case "text":
+		printChanges(stdout, changes)
+	case "json":
+		if changes == nil {
+			changes = []catalog.Change{}
+		}
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(changes); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported format %q", *format)
+	}
+
+	if breaking := len(catalog.Breaking(changes)); breaking > 0 {
+		return fmt.Errorf("breaking changes: %d", breaking)
+	}
+
+	return nil
+}
+
+func readExport(path string) ([]catalog.Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := catalog.ParseExport(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return entries, nil
+}
+
+func printChanges(w io.Writer, changes []catalog.Change) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No changes.")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "IMPACT\tCODE\tCHANGE\tOLD\tNEW")
+	for _, c := range changes {
+		impact := "non-breaking"
+		if c.Breaking {
+			impact = "breaking"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", impact, c.Code, c.Kind, c.Old, c.New)
+	}
+
+	tw.Flush()
+}
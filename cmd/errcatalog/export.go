@@ -0,0 +1,86 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/internal/markdown"
+)
+
+func encodeCSV(w io.Writer, entries []catalog.Entry) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"namespace", "package", "function", "code", "title", "description", "statusCode", "classification", "level", "type", "anchor", "links"}); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		record := []string{e.Namespace, e.Package, e.Function, e.Code, e.Title, e.Description, strconv.Itoa(e.StatusCode), e.Classification, e.Level, e.Type, e.Anchor, strings.Join(e.Links, " ")}
+		for i := range record {
+			record[i] = csvCell(record[i])
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvCell prefixes cells which spreadsheets would evaluate as formulas with a
+// quote, so that opening an export cannot run one.
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@", rune(s[0])) {
+		return "'" + s
+	}
+
+	return s
+}
+
+func encodeMarkdown(w io.Writer, entries []catalog.Entry) error {
+	var sb strings.Builder
+	namespace := ""
+
+	for _, e := range entries {
+		if e.Namespace != namespace {
+			namespace = e.Namespace
+			fmt.Fprintf(&sb, "## %s\n\n", namespace)
+		}
+
+		fmt.Fprintf(&sb, "### %s\n\n", e.Code)
+		fmt.Fprintf(&sb, "**%s**\n\n", markdown.Escape(e.Title))
+		fmt.Fprintf(&sb, "%s\n\n", markdown.EscapeLines(e.Description, markdown.EscapeInline, false))
+		fmt.Fprintf(&sb, "- Status code: %d\n", e.StatusCode)
+		fmt.Fprintf(&sb, "- Classification: %s\n", e.Classification)
+		fmt.Fprintf(&sb, "- Level: %s\n", e.Level)
+		fmt.Fprintf(&sb, "- Constructor: `%s.%s`\n", e.Package[strings.LastIndex(e.Package, "/")+1:], e.Function)
+		fmt.Fprintf(&sb, "- Documentation: <%s>\n", e.Type)
+		for _, link := range e.Links {
+			fmt.Fprintf(&sb, "- See: <%s>\n", link)
+		}
+		sb.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,92 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command errcatalog looks up, searches and exports the Error Catalog.
+//
+// Usage:
+//
+//	errcatalog lookup SNYK-CLI-0008
+//	errcatalog list [--namespace NAME] [--classification CLASS] [--level LEVEL]
+//	errcatalog search "lock file"
+//	errcatalog export --format json|yaml|csv|markdown
+//	errcatalog decode < error.json
+//	errcatalog diff [--format text|json] old.json [new.json]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+const usage = `Usage: errcatalog <command> [arguments]
+
+Commands:
+  lookup <code>     print the catalog entry of an error code
+  list              list catalog entries, optionally filtered
+  search <query>    search the titles and descriptions of the catalog
+  export            export the catalog as json, yaml, csv or markdown
+  decode            pretty-print a JSON:API error document read from stdin
+  diff <old> [new]  compare two catalog exports, or an export with this
+                    catalog, and fail on breaking changes
+`
+
+type command func(args []string, stdin io.Reader, stdout io.Writer) error
+
+var commands = map[string]command{
+	"lookup": lookup,
+	"list":   list,
+	"search": search,
+	"export": export,
+	"decode": decode,
+	"diff":   diff,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	switch args[0] {
+	case "-h", "--help", "help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "errcatalog: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+
+	err := cmd(args[1:], stdin, stdout)
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case err != nil:
+		fmt.Fprintf(stderr, "errcatalog %s: %v\n", args[0], err)
+		return 1
+	}
+
+	return 0
+}
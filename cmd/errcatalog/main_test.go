@@ -0,0 +1,316 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/cli"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func execute(t *testing.T, stdin string, args ...string) (string, string, int) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+
+	return stdout.String(), stderr.String(), code
+}
+
+func TestRun_Usage(t *testing.T) {
+	type test struct {
+		description string
+		args        []string
+		exitCode    int
+		stderr      string
+	}
+
+	tests := []test{
+		{description: "no command", exitCode: 2, stderr: "Usage: errcatalog"},
+		{description: "unknown command", args: []string{"frobnicate"}, exitCode: 2, stderr: `unknown command "frobnicate"`},
+		{description: "lookup without code", args: []string{"lookup"}, exitCode: 1, stderr: "expected exactly one error code"},
+		{description: "lookup unknown code", args: []string{"lookup", "SNYK-NOPE-0001"}, exitCode: 1, stderr: "unknown error code"},
+		{description: "search without query", args: []string{"search"}, exitCode: 1, stderr: "expected a search query"},
+		{description: "export unknown format", args: []string{"export", "--format", "xml"}, exitCode: 1, stderr: `unsupported format "xml"`},
+		{description: "list unknown flag", args: []string{"list", "--colour"}, exitCode: 1, stderr: "flag provided but not defined"},
+		{description: "list extra arguments", args: []string{"list", "cli"}, exitCode: 1, stderr: "unexpected arguments: cli"},
+		{description: "lookup extra arguments", args: []string{"lookup", "SNYK-CLI-0008", "SNYK-CLI-0009"}, exitCode: 1, stderr: "expected exactly one error code"},
+		{description: "export extra arguments", args: []string{"export", "--format", "csv", "catalog.csv"}, exitCode: 1, stderr: "unexpected arguments: catalog.csv"},
+		{description: "decode invalid document", args: []string{"decode"}, exitCode: 1, stderr: "invalid JSON:API error document"},
+		{description: "diff without exports", args: []string{"diff"}, exitCode: 1, stderr: "expected an old export"},
+		{description: "diff missing export", args: []string{"diff", "missing.json"}, exitCode: 1, stderr: "missing.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			_, stderr, code := execute(t, "not json", tt.args...)
+			require.Equal(t, tt.exitCode, code)
+			require.Contains(t, stderr, tt.stderr)
+		})
+	}
+}
+
+func TestRun_Help(t *testing.T) {
+	type test struct {
+		description string
+		args        []string
+		stdout      string
+	}
+
+	tests := []test{
+		{description: "help command", args: []string{"help"}, stdout: "Usage: errcatalog <command>"},
+		{description: "help flag", args: []string{"--help"}, stdout: "Usage: errcatalog <command>"},
+		{description: "list", args: []string{"list", "-h"}, stdout: "Usage: errcatalog list [flags]\n\nFlags:\n  -classification"},
+		{description: "export", args: []string{"export", "--help"}, stdout: "-format string"},
+		{description: "diff", args: []string{"diff", "-help"}, stdout: "Usage: errcatalog diff [flags] <old> [new]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			stdout, stderr, code := execute(t, "", tt.args...)
+			require.Equal(t, 0, code)
+			require.Empty(t, stderr)
+			require.Contains(t, stdout, tt.stdout)
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	stdout, _, code := execute(t, "", "lookup", "snyk-cli-0008")
+	require.Equal(t, 0, code)
+
+	entry, ok := catalog.Lookup("SNYK-CLI-0008")
+	require.True(t, ok)
+	require.Contains(t, stdout, "SNYK-CLI-0008")
+	require.Contains(t, stdout, entry.Title)
+	require.Contains(t, stdout, entry.Description)
+	require.Contains(t, stdout, "cli.NewNoSupportedFilesFoundError")
+	require.Contains(t, stdout, entry.Type)
+}
+
+func TestLookup_Deprecated(t *testing.T) {
+	stdout, _, code := execute(t, "", "lookup", "SNYK-OS-GO-0003")
+	require.Equal(t, 0, code)
+
+	require.True(t, strings.HasPrefix(stdout, "SNYK-OS-GO-0003 is deprecated since 1.0.0 and replaced by SNYK-OS-8004.\n\n"))
+	require.Contains(t, stdout, "Code:           SNYK-OS-8004\n")
+}
+
+func TestList(t *testing.T) {
+	stdout, _, code := execute(t, "", "list", "--namespace", "OpenSourceEcosystems", "--classification", "unsupported")
+	require.Equal(t, 0, code)
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Greater(t, len(lines), 1)
+	require.True(t, strings.HasPrefix(lines[0], "CODE"))
+
+	expected := 0
+	for _, entry := range catalog.All() {
+		if entry.Namespace == "OpenSourceEcosystems" && entry.Classification == "UNSUPPORTED" {
+			expected++
+		}
+	}
+	require.Len(t, lines[1:], expected)
+
+	for _, line := range lines[1:] {
+		require.Contains(t, line, "OpenSourceEcosystems")
+		require.Contains(t, line, "UNSUPPORTED")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	stdout, _, code := execute(t, "", "search", "lock", "FILE")
+	require.Equal(t, 0, code)
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Greater(t, len(lines), 1)
+	// entries matching in their title are listed first
+	require.Contains(t, strings.ToLower(lines[1]), "lock file")
+
+	stdout, _, code = execute(t, "", "search", "no such words anywhere")
+	require.Equal(t, 0, code)
+	require.Equal(t, 1, strings.Count(stdout, "\n"))
+}
+
+func TestExport(t *testing.T) {
+	entries := catalog.All()
+
+	t.Run("json", func(t *testing.T) {
+		stdout, _, code := execute(t, "", "export", "--format", "json")
+		require.Equal(t, 0, code)
+		require.JSONEq(t, string(catalog.JSON()), stdout)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		stdout, _, code := execute(t, "", "export", "--format", "yaml")
+		require.Equal(t, 0, code)
+
+		var actual []catalog.Entry
+		require.NoError(t, yaml.Unmarshal([]byte(stdout), &actual))
+		require.Equal(t, entries, actual)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		stdout, _, code := execute(t, "", "export", "--format", "csv")
+		require.Equal(t, 0, code)
+
+		records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, len(entries)+1)
+		require.Equal(t, "code", records[0][3])
+		require.Equal(t, entries[0].Code, records[1][3])
+		require.Equal(t, entries[0].Description, records[1][5])
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		stdout, _, code := execute(t, "", "export", "--format", "markdown")
+		require.Equal(t, 0, code)
+		require.True(t, strings.HasPrefix(stdout, "## Snyk\n"))
+		for _, entry := range entries {
+			require.Contains(t, stdout, "\n### "+entry.Code+"\n")
+		}
+	})
+}
+
+func TestEncodeCSV_NeutralisesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, encodeCSV(&buf, []catalog.Entry{{
+		Code:        "SNYK-TEST-0001",
+		Title:       "=HYPERLINK(\"https://example.com\")",
+		Description: "+1 more",
+		Type:        "-2",
+		Anchor:      "@SUM(A1)",
+		Package:     "a-b",
+	}}))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, "SNYK-TEST-0001", records[1][3])
+	require.Equal(t, "'=HYPERLINK(\"https://example.com\")", records[1][4])
+	require.Equal(t, "'+1 more", records[1][5])
+	require.Equal(t, "'-2", records[1][9])
+	require.Equal(t, "'@SUM(A1)", records[1][10])
+	require.Equal(t, "a-b", records[1][1])
+}
+
+func TestEncodeMarkdown_Escapes(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, encodeMarkdown(&buf, []catalog.Entry{{
+		Namespace:   "Test",
+		Package:     "github.com/snyk/error-catalog-golang-public/test",
+		Function:    "NewError",
+		Code:        "SNYK-TEST-0001",
+		Title:       "# <b>Bold</b> claim",
+		Description: "Ask @octocat about [this](https://example.com) or #123.",
+		Type:        "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-test-0001",
+	}}))
+
+	require.Contains(t, buf.String(), "**\\# \\<b\\>Bold\\</b\\> claim**\n")
+	require.Contains(t, buf.String(), "Ask @\u200doctocat about \\[this\\](https://example.com) or #\u200d123.\n")
+}
+
+func TestDecode(t *testing.T) {
+	errs := []snyk_errors.Error{
+		cli.NewNoSupportedFilesFoundError("no manifest in /src"),
+		{Title: "Unknown", ErrorCode: "ACME-0001", Detail: "not from the catalog"},
+	}
+
+	var doc struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	for _, err := range errs {
+		var buf bytes.Buffer
+		require.NoError(t, err.MarshalToJSONAPIError(&buf, ""))
+
+		var single struct {
+			Errors []json.RawMessage `json:"errors"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &single))
+		doc.Errors = append(doc.Errors, single.Errors...)
+	}
+	input, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	stdout, stderr, code := execute(t, string(input), "decode")
+	require.Equal(t, 0, code, stderr)
+
+	require.Contains(t, stdout, "No supported files found (SNYK-CLI-0008)")
+	require.Contains(t, stdout, "no manifest in /src")
+	// the description is not part of the document and is taken from the catalog
+	require.Contains(t, stdout, "Snyk could not detect any supported")
+	require.Contains(t, stdout, "Unknown (ACME-0001)")
+	require.Contains(t, stdout, "2 errors")
+	require.NotContains(t, stdout, "\x1b[")
+}
+
+func TestDecodeDeprecatedCode(t *testing.T) {
+	input := `{"errors":[{"title":"OAuth re-authorization required","status":"422","code":"SNYK-OS-GO-0003"}]}`
+
+	stdout, stderr, code := execute(t, input, "decode")
+	require.Equal(t, 0, code, stderr)
+
+	require.Contains(t, stdout, "(SNYK-OS-GO-0003)")
+	require.Contains(t, stdout, "#snyk-os-go-0003")
+	require.NotContains(t, stdout, "8004")
+}
+
+func TestDiff(t *testing.T) {
+	writeExport := func(t *testing.T, entries []catalog.Entry) string {
+		t.Helper()
+
+		var buf bytes.Buffer
+		require.NoError(t, catalog.EncodeJSON(&buf, entries))
+
+		path := filepath.Join(t.TempDir(), "catalog.json")
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+		return path
+	}
+
+	current := writeExport(t, catalog.All())
+
+	stdout, _, code := execute(t, "", "diff", current)
+	require.Equal(t, 0, code)
+	require.Equal(t, "No changes.\n", stdout)
+
+	old := catalog.All()
+	old[0].Level = "error"
+	previous := writeExport(t, old[:len(old)-1])
+
+	stdout, stderr, code := execute(t, "", "diff", previous, current)
+	require.Equal(t, 1, code)
+	require.Contains(t, stderr, "breaking changes: 1")
+	require.Contains(t, stdout, "breaking      SNYK-0001")
+	require.Contains(t, stdout, "non-breaking  "+old[len(old)-1].Code+"  added")
+
+	stdout, _, code = execute(t, "", "diff", "--format", "json", previous)
+	require.Equal(t, 1, code)
+
+	var changes []catalog.Change
+	require.NoError(t, json.Unmarshal([]byte(stdout), &changes))
+	require.Equal(t, catalog.Change{Code: "SNYK-0001", Kind: catalog.ChangeLevel, Old: "error", New: "warn", Breaking: true}, changes[0])
+}
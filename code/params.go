@@ -0,0 +1,68 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package code
+
+import (
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+var (
+	analysisFileCountLimitExceededDetail      = snyk_errors.MustParseDetail("SNYK-CODE-0001", "The analysis target{{if .Path}} {{.Path}}{{end}}{{if .Actual}} contains {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	analysisResultSizeLimitExceededDetail     = snyk_errors.MustParseDetail("SNYK-CODE-0002", "The analysis result{{if .Path}} of {{.Path}}{{end}}{{if .Actual}} is {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	analysisTargetSizeLimitExceededDetail     = snyk_errors.MustParseDetail("SNYK-CODE-0003", "The analysis target{{if .Path}} {{.Path}}{{end}}{{if .Actual}} is {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	analysisFileNameLengthLimitExceededDetail = snyk_errors.MustParseDetail("SNYK-CODE-0004", "{{if .Path}}The file name {{.Path}}{{else}}A file name{{end}}{{if .Actual}} is {{.ActualWithUnit}} long, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	ruleExtensionsLimitReachedDetail          = snyk_errors.MustParseDetail("SNYK-CODE-0011", "The Group{{if .Actual}} has {{.ActualWithUnit}}, which{{end}} reaches the limit of {{.LimitWithUnit}}.")
+)
+
+// NewAnalysisFileCountLimitExceededErrorWithParams is NewAnalysisFileCountLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewAnalysisFileCountLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewAnalysisFileCountLimitExceededError(snyk_errors.RenderDetail(analysisFileCountLimitExceededDetail, params), options...)
+}
+
+// NewAnalysisResultSizeLimitExceededErrorWithParams is NewAnalysisResultSizeLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewAnalysisResultSizeLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewAnalysisResultSizeLimitExceededError(snyk_errors.RenderDetail(analysisResultSizeLimitExceededDetail, params), options...)
+}
+
+// NewAnalysisTargetSizeLimitExceededErrorWithParams is NewAnalysisTargetSizeLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewAnalysisTargetSizeLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewAnalysisTargetSizeLimitExceededError(snyk_errors.RenderDetail(analysisTargetSizeLimitExceededDetail, params), options...)
+}
+
+// NewAnalysisFileNameLengthLimitExceededErrorWithParams is NewAnalysisFileNameLengthLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewAnalysisFileNameLengthLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewAnalysisFileNameLengthLimitExceededError(snyk_errors.RenderDetail(analysisFileNameLengthLimitExceededDetail, params), options...)
+}
+
+// NewRuleExtensionsLimitReachedErrorWithParams is NewRuleExtensionsLimitReachedError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewRuleExtensionsLimitReachedErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewRuleExtensionsLimitReachedError(snyk_errors.RenderDetail(ruleExtensionsLimitReachedDetail, params), options...)
+}
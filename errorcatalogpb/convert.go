@@ -0,0 +1,197 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package errorcatalogpb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// CauseError is a link of a decoded cause chain which is not a catalog error.
+// Only its message survives the protobuf encoding.
+type CauseError struct {
+	Message string
+	Cause   error
+}
+
+func (e *CauseError) Error() string {
+	return e.Message
+}
+
+func (e *CauseError) Unwrap() error {
+	return e.Cause
+}
+
+// ToProto converts err to its protobuf message. Meta values are converted
+// like their JSON encoding, so converting fails for values which cannot be
+// encoded as JSON, and FromProto returns numbers of any type as float64.
+// Converting also fails for status codes out of the int32 range.
+//
+// Protobuf strings must be valid UTF-8, so invalid bytes in strings, such as
+// binary tool output in the logs, are replaced by U+FFFD, as in JSON.
+func ToProto(err snyk_errors.Error) (*Error, error) {
+	if err.StatusCode < math.MinInt32 || err.StatusCode > math.MaxInt32 {
+		return nil, fmt.Errorf("status code %d of %s is out of range", err.StatusCode, err.ErrorCode)
+	}
+
+	msg := &Error{
+		Id:             validUTF8(err.ID),
+		Type:           validUTF8(err.Type),
+		Title:          validUTF8(err.Title),
+		StatusCode:     int32(err.StatusCode),
+		ErrorCode:      validUTF8(err.ErrorCode),
+		Description:    validUTF8(err.Description),
+		Level:          validUTF8(err.Level),
+		Links:          validUTF8s(err.Links),
+		Detail:         validUTF8(err.Detail),
+		Classification: validUTF8(err.Classification),
+		Logs:           validUTF8s(err.Logs),
+		HasLinks:       err.Links != nil,
+		HasLogs:        err.Logs != nil,
+	}
+
+	if err.Meta != nil {
+		meta, convErr := toStruct(err.Meta)
+		if convErr != nil {
+			return nil, fmt.Errorf("converting meta of %s: %w", err.ErrorCode, convErr)
+		}
+		msg.Meta = meta
+	}
+
+	cause, convErr := toCause(err.Cause)
+	if convErr != nil {
+		return nil, convErr
+	}
+	msg.Cause = cause
+
+	return msg, nil
+}
+
+// FromProto converts a protobuf message back to an error. Links of the cause
+// chain which are not catalog errors are restored as *CauseError, and numbers
+// in meta are float64.
+func FromProto(msg *Error) snyk_errors.Error {
+	err := snyk_errors.Error{
+		ID:             msg.GetId(),
+		Type:           msg.GetType(),
+		Title:          msg.GetTitle(),
+		StatusCode:     int(msg.GetStatusCode()),
+		ErrorCode:      msg.GetErrorCode(),
+		Description:    msg.GetDescription(),
+		Level:          msg.GetLevel(),
+		Detail:         msg.GetDetail(),
+		Cause:          fromCause(msg.GetCause()),
+		Classification: msg.GetClassification(),
+	}
+
+	if msg.GetHasLinks() {
+		err.Links = append([]string{}, msg.GetLinks()...)
+	}
+
+	if msg.GetHasLogs() {
+		err.Logs = append([]string{}, msg.GetLogs()...)
+	}
+
+	if msg.GetMeta() != nil {
+		err.Meta = msg.GetMeta().AsMap()
+	}
+
+	return err
+}
+
+func toStruct(meta map[string]any) (*structpb.Struct, error) {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]any
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+
+	return structpb.NewStruct(values)
+}
+
+func validUTF8(s string) string {
+	return strings.ToValidUTF8(s, "\uFFFD")
+}
+
+// validUTF8s returns the valid UTF-8 of values, keeping nil and empty
+// slices apart.
+func validUTF8s(values []string) []string {
+	if values == nil {
+		return nil
+	}
+
+	valid := make([]string, len(values))
+	for i, value := range values {
+		valid[i] = validUTF8(value)
+	}
+
+	return valid
+}
+
+func toCause(err error) (*Cause, error) {
+	if err == nil {
+		return nil, nil
+	}
+
+	var catalogErr *snyk_errors.Error
+	switch e := err.(type) {
+	case snyk_errors.Error:
+		catalogErr = &e
+	case *snyk_errors.Error:
+		if e == nil {
+			return nil, nil
+		}
+		catalogErr = e
+	}
+
+	if catalogErr != nil {
+		msg, convErr := ToProto(*catalogErr)
+		if convErr != nil {
+			return nil, convErr
+		}
+
+		return &Cause{Kind: &Cause_Error{Error: msg}}, nil
+	}
+
+	next, convErr := toCause(errors.Unwrap(err))
+	if convErr != nil {
+		return nil, convErr
+	}
+
+	return &Cause{Kind: &Cause_Message{Message: validUTF8(err.Error())}, Cause: next}, nil
+}
+
+func fromCause(cause *Cause) error {
+	switch kind := cause.GetKind().(type) {
+	case *Cause_Error:
+		return FromProto(kind.Error)
+	case *Cause_Message:
+		return &CauseError{Message: kind.Message, Cause: fromCause(cause.GetCause())}
+	default:
+		return nil
+	}
+}
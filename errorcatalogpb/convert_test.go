@@ -0,0 +1,310 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package errorcatalogpb_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/snyk/error-catalog-golang-public/errorcatalogpb"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// roundTrip converts err to protobuf, through the wire format and back.
+func roundTrip(t *testing.T, err snyk_errors.Error) snyk_errors.Error {
+	t.Helper()
+
+	msg, convErr := errorcatalogpb.ToProto(err)
+	require.NoError(t, convErr)
+
+	data, marshalErr := proto.Marshal(msg)
+	require.NoError(t, marshalErr)
+
+	var decoded errorcatalogpb.Error
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+
+	return errorcatalogpb.FromProto(&decoded)
+}
+
+// TestRoundTrip_Property converts random errors, with every field set or not
+// and causes nested up to three levels deep, and requires them to survive
+// unchanged but for numbers in meta, which become float64, and invalid UTF-8,
+// which becomes U+FFFD.
+func TestRoundTrip_Property(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		err := randomError(r, 3)
+		require.Equal(t, converted(t, err), roundTrip(t, err), "iteration %d", i)
+	}
+}
+
+// converted returns err, and the catalog errors of its cause chain, as
+// converting changes them: with valid UTF-8 and the meta JSON decodes from
+// their meta.
+func converted(t *testing.T, err snyk_errors.Error) snyk_errors.Error {
+	t.Helper()
+
+	err.ID = validUTF8(err.ID)
+	err.Type = validUTF8(err.Type)
+	err.Title = validUTF8(err.Title)
+	err.ErrorCode = validUTF8(err.ErrorCode)
+	err.Description = validUTF8(err.Description)
+	err.Level = validUTF8(err.Level)
+	err.Links = validUTF8s(err.Links)
+	err.Detail = validUTF8(err.Detail)
+	err.Classification = validUTF8(err.Classification)
+	err.Logs = validUTF8s(err.Logs)
+
+	if err.Meta != nil {
+		data, marshalErr := json.Marshal(err.Meta)
+		require.NoError(t, marshalErr)
+
+		err.Meta = nil
+		require.NoError(t, json.Unmarshal(data, &err.Meta))
+	}
+
+	err.Cause = convertedCause(t, err.Cause)
+
+	return err
+}
+
+func convertedCause(t *testing.T, cause error) error {
+	t.Helper()
+
+	switch cause := cause.(type) {
+	case snyk_errors.Error:
+		return converted(t, cause)
+	case *errorcatalogpb.CauseError:
+		return &errorcatalogpb.CauseError{Message: validUTF8(cause.Message), Cause: convertedCause(t, cause.Cause)}
+	default:
+		return cause
+	}
+}
+
+func validUTF8(s string) string {
+	return strings.ToValidUTF8(s, "\uFFFD")
+}
+
+func validUTF8s(values []string) []string {
+	if values == nil {
+		return nil
+	}
+
+	valid := make([]string, len(values))
+	for i, value := range values {
+		valid[i] = validUTF8(value)
+	}
+
+	return valid
+}
+
+func TestRoundTrip_EmptySlices(t *testing.T) {
+	for _, values := range [][]string{nil, {}, {"https://docs.snyk.io"}} {
+		err := ecosystems.NewUnparseableManifestError("")
+		err.Links = values
+		err.Logs = values
+
+		actual := roundTrip(t, err)
+		require.Equal(t, values, actual.Links)
+		require.Equal(t, values, actual.Logs)
+	}
+}
+
+func TestRoundTrip_InvalidUTF8(t *testing.T) {
+	err := ecosystems.NewUnparseableManifestError("bad \xff byte",
+		snyk_errors.WithLogs([]string{"\xfe\xff"}),
+		snyk_errors.WithMeta("output", "\xff"),
+		snyk_errors.WithCause(errors.New("cause \xff")),
+	)
+
+	actual := roundTrip(t, err)
+	require.Equal(t, "bad \uFFFD byte", actual.Detail)
+	require.Equal(t, []string{"\uFFFD"}, actual.Logs)
+	require.Equal(t, map[string]any{"output": "\uFFFD"}, actual.Meta)
+	require.EqualError(t, actual.Cause, "cause \uFFFD")
+}
+
+func TestToProto_NilCause(t *testing.T) {
+	var cause *snyk_errors.Error
+	err := ecosystems.NewUnparseableManifestError("", snyk_errors.WithCause(cause))
+
+	msg, convErr := errorcatalogpb.ToProto(err)
+	require.NoError(t, convErr)
+	require.Nil(t, msg.GetCause())
+}
+
+func TestRoundTrip_CauseChain(t *testing.T) {
+	inner := ecosystems.NewUnparseableManifestError("package.json: unexpected token")
+	err := ecosystems.NewUnparseableLockFileError("yarn.lock",
+		snyk_errors.WithCause(fmt.Errorf("parsing workspace: %w", &inner)),
+		snyk_errors.WithMeta("manifests", []string{"package.json"}),
+		snyk_errors.WithMeta("count", 2),
+	)
+
+	actual := roundTrip(t, err)
+
+	require.Equal(t, err.Error(), actual.Error())
+	require.Equal(t, map[string]any{"manifests": []any{"package.json"}, "count": float64(2)}, actual.Meta)
+
+	var wrapper *errorcatalogpb.CauseError
+	require.ErrorAs(t, actual.Cause, &wrapper)
+	require.Equal(t, "parsing workspace: "+inner.Error(), wrapper.Error())
+
+	var cause snyk_errors.Error
+	require.ErrorAs(t, wrapper, &cause)
+	require.Equal(t, inner, cause)
+}
+
+func TestToProto_InvalidMeta(t *testing.T) {
+	err := ecosystems.NewUnparseableManifestError("", snyk_errors.WithMeta("callback", func() {}))
+
+	_, convErr := errorcatalogpb.ToProto(err)
+	require.ErrorContains(t, convErr, "converting meta of "+err.ErrorCode)
+
+	wrapped := ecosystems.NewUnparseableLockFileError("", snyk_errors.WithCause(errors.Join(err)))
+	_, convErr = errorcatalogpb.ToProto(wrapped)
+	require.NoError(t, convErr, "only single-error chains are followed")
+}
+
+func TestToProto_StatusCodeOutOfRange(t *testing.T) {
+	maxInt32 := int64(math.MaxInt32)
+
+	err := ecosystems.NewUnparseableManifestError("")
+	err.StatusCode = int(maxInt32 + 1)
+
+	_, convErr := errorcatalogpb.ToProto(err)
+	require.ErrorContains(t, convErr, "out of range")
+}
+
+func randomError(r *rand.Rand, depth int) snyk_errors.Error {
+	err := snyk_errors.Error{
+		ID:             randomString(r),
+		Type:           randomString(r),
+		Title:          randomString(r),
+		StatusCode:     int(r.Int31()) - r.Intn(2)*int(r.Int31()),
+		ErrorCode:      randomString(r),
+		Description:    randomString(r),
+		Level:          randomString(r),
+		Links:          randomStrings(r),
+		Detail:         randomString(r),
+		Classification: randomString(r),
+		Logs:           randomStrings(r),
+	}
+
+	if r.Intn(2) == 0 {
+		err.Meta = randomObject(r, 2)
+	}
+
+	if depth > 0 {
+		err.Cause = randomCause(r, depth-1)
+	}
+
+	return err
+}
+
+func randomCause(r *rand.Rand, depth int) error {
+	switch r.Intn(3) {
+	case 0:
+		return nil
+	case 1:
+		return randomError(r, depth)
+	default:
+		cause := &errorcatalogpb.CauseError{Message: randomString(r)}
+		if depth > 0 {
+			cause.Cause = randomCause(r, depth-1)
+		}
+		return cause
+	}
+}
+
+func randomString(r *rand.Rand) string {
+	if r.Intn(4) == 0 {
+		return ""
+	}
+
+	// The invalid UTF-8 of a lone continuation byte, a truncated sequence and
+	// a byte which never occurs in UTF-8 is mixed in with valid text.
+	parts := []string{"a", "b", "c", "X", "Y", "Z", "0", "9", "-", "_", " ", "#", "ä", "ß", "€", "🙂", "\n", "\x80", "\xe2\x82", "\xff"}
+	var s strings.Builder
+	for i := r.Intn(12) + 1; i > 0; i-- {
+		s.WriteString(parts[r.Intn(len(parts))])
+	}
+
+	return s.String()
+}
+
+func randomStrings(r *rand.Rand) []string {
+	n := r.Intn(5) - 1
+	if n < 0 {
+		return nil
+	}
+
+	s := make([]string, n)
+	for i := range s {
+		s[i] = randomString(r)
+	}
+
+	return s
+}
+
+// randomObject returns meta with values of the types JSON decodes into, and
+// ints.
+func randomObject(r *rand.Rand, depth int) map[string]any {
+	object := make(map[string]any)
+	for i := r.Intn(4); i > 0; i-- {
+		object[randomString(r)] = randomValue(r, depth)
+	}
+
+	return object
+}
+
+func randomValue(r *rand.Rand, depth int) any {
+	kinds := 5
+	if depth > 0 {
+		kinds = 7
+	}
+
+	switch r.Intn(kinds) {
+	case 0:
+		return nil
+	case 1:
+		return r.Intn(2) == 0
+	case 2:
+		return r.NormFloat64() * 1e6
+	case 3:
+		return randomString(r)
+	case 4:
+		return r.Intn(2_000_000) - 1_000_000
+	case 5:
+		values := make([]any, r.Intn(3))
+		for i := range values {
+			values[i] = randomValue(r, depth-1)
+		}
+		return values
+	default:
+		return randomObject(r, depth-1)
+	}
+}
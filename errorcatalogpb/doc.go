@@ -0,0 +1,22 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package errorcatalogpb is the protobuf encoding of Error Catalog errors, for
+// event buses and gRPC services. The messages are declared in
+// proto/snyk/errorcatalog/v1/error.proto.
+package errorcatalogpb
+
+//go:generate go run -C ../internal/protoc . -I ../../proto -out ../../errorcatalogpb snyk/errorcatalog/v1/error.proto
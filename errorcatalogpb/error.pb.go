@@ -0,0 +1,434 @@
+//
+// © 2026 Snyk Limited
+//
+// Licensed under the Apache License, Version 2.0 (the 'License');
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an 'AS IS' BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: snyk/errorcatalog/v1/error.proto
+
+package errorcatalogpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Error is an Error Catalog error, mirroring the Go type snyk_errors.Error.
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Type is the documentation URL of the error.
+	Type        string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title       string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	StatusCode  int32    `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	ErrorCode   string   `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Description string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Level       string   `protobuf:"bytes,7,opt,name=level,proto3" json:"level,omitempty"`
+	Links       []string `protobuf:"bytes,8,rep,name=links,proto3" json:"links,omitempty"`
+	Detail      string   `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	// Meta holds the structured meta of the error. Values are converted like
+	// their JSON encoding, so numbers of any type are decoded as doubles.
+	Meta *structpb.Struct `protobuf:"bytes,10,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Cause is the first link of the chain of errors wrapped by the error.
+	Cause          *Cause   `protobuf:"bytes,11,opt,name=cause,proto3" json:"cause,omitempty"`
+	Classification string   `protobuf:"bytes,12,opt,name=classification,proto3" json:"classification,omitempty"`
+	Logs           []string `protobuf:"bytes,13,rep,name=logs,proto3" json:"logs,omitempty"`
+	// HasLinks is set when the links are not nil, so that empty links, which a
+	// repeated field cannot tell from none, survive.
+	HasLinks bool `protobuf:"varint,14,opt,name=has_links,json=hasLinks,proto3" json:"has_links,omitempty"`
+	// HasLogs is set when the logs are not nil, like HasLinks.
+	HasLogs bool `protobuf:"varint,15,opt,name=has_logs,json=hasLogs,proto3" json:"has_logs,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_snyk_errorcatalog_v1_error_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_snyk_errorcatalog_v1_error_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_snyk_errorcatalog_v1_error_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Error) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Error) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Error) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Error) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Error) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *Error) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Error) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Error) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Error) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Error) GetMeta() *structpb.Struct {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *Error) GetCause() *Cause {
+	if x != nil {
+		return x.Cause
+	}
+	return nil
+}
+
+func (x *Error) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *Error) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *Error) GetHasLinks() bool {
+	if x != nil {
+		return x.HasLinks
+	}
+	return false
+}
+
+func (x *Error) GetHasLogs() bool {
+	if x != nil {
+		return x.HasLogs
+	}
+	return false
+}
+
+// Cause is a link of the chain of errors wrapped by an Error. Catalog errors
+// are kept as a whole, with their own cause. Of other errors only the message
+// is kept, followed by the error they wrap.
+type Cause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*Cause_Error
+	//	*Cause_Message
+	Kind isCause_Kind `protobuf_oneof:"kind"`
+	// Cause is the next link of the chain after an error which is kept as a
+	// message.
+	Cause *Cause `protobuf:"bytes,3,opt,name=cause,proto3" json:"cause,omitempty"`
+}
+
+func (x *Cause) Reset() {
+	*x = Cause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_snyk_errorcatalog_v1_error_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cause) ProtoMessage() {}
+
+func (x *Cause) ProtoReflect() protoreflect.Message {
+	mi := &file_snyk_errorcatalog_v1_error_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cause.ProtoReflect.Descriptor instead.
+func (*Cause) Descriptor() ([]byte, []int) {
+	return file_snyk_errorcatalog_v1_error_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *Cause) GetKind() isCause_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Cause) GetError() *Error {
+	if x, ok := x.GetKind().(*Cause_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *Cause) GetMessage() string {
+	if x, ok := x.GetKind().(*Cause_Message); ok {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Cause) GetCause() *Cause {
+	if x != nil {
+		return x.Cause
+	}
+	return nil
+}
+
+type isCause_Kind interface {
+	isCause_Kind()
+}
+
+type Cause_Error struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type Cause_Message struct {
+	Message string `protobuf:"bytes,2,opt,name=message,proto3,oneof"`
+}
+
+func (*Cause_Error) isCause_Kind() {}
+
+func (*Cause_Message) isCause_Kind() {}
+
+var File_snyk_errorcatalog_v1_error_proto protoreflect.FileDescriptor
+
+var file_snyk_errorcatalog_v1_error_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x73, 0x6e, 0x79, 0x6b, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x14, 0x73, 0x6e, 0x79, 0x6b, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x03, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x31, 0x0a,
+	0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x6e, 0x79, 0x6b, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x68, 0x61, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73,
+	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73,
+	0x4c, 0x6f, 0x67, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x05, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x6e, 0x79, 0x6b, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x31, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x6e, 0x79, 0x6b, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x63, 0x61, 0x75,
+	0x73, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6e, 0x79, 0x6b, 0x2f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x2d, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2d, 0x67, 0x6f, 0x6c, 0x61,
+	0x6e, 0x67, 0x2d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_snyk_errorcatalog_v1_error_proto_rawDescOnce sync.Once
+	file_snyk_errorcatalog_v1_error_proto_rawDescData = file_snyk_errorcatalog_v1_error_proto_rawDesc
+)
+
+func file_snyk_errorcatalog_v1_error_proto_rawDescGZIP() []byte {
+	file_snyk_errorcatalog_v1_error_proto_rawDescOnce.Do(func() {
+		file_snyk_errorcatalog_v1_error_proto_rawDescData = protoimpl.X.CompressGZIP(file_snyk_errorcatalog_v1_error_proto_rawDescData)
+	})
+	return file_snyk_errorcatalog_v1_error_proto_rawDescData
+}
+
+var file_snyk_errorcatalog_v1_error_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_snyk_errorcatalog_v1_error_proto_goTypes = []any{
+	(*Error)(nil),           // 0: snyk.errorcatalog.v1.Error
+	(*Cause)(nil),           // 1: snyk.errorcatalog.v1.Cause
+	(*structpb.Struct)(nil), // 2: google.protobuf.Struct
+}
+var file_snyk_errorcatalog_v1_error_proto_depIdxs = []int32{
+	2, // 0: snyk.errorcatalog.v1.Error.meta:type_name -> google.protobuf.Struct
+	1, // 1: snyk.errorcatalog.v1.Error.cause:type_name -> snyk.errorcatalog.v1.Cause
+	0, // 2: snyk.errorcatalog.v1.Cause.error:type_name -> snyk.errorcatalog.v1.Error
+	1, // 3: snyk.errorcatalog.v1.Cause.cause:type_name -> snyk.errorcatalog.v1.Cause
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_snyk_errorcatalog_v1_error_proto_init() }
+func file_snyk_errorcatalog_v1_error_proto_init() {
+	if File_snyk_errorcatalog_v1_error_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_snyk_errorcatalog_v1_error_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_snyk_errorcatalog_v1_error_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Cause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_snyk_errorcatalog_v1_error_proto_msgTypes[1].OneofWrappers = []any{
+		(*Cause_Error)(nil),
+		(*Cause_Message)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_snyk_errorcatalog_v1_error_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_snyk_errorcatalog_v1_error_proto_goTypes,
+		DependencyIndexes: file_snyk_errorcatalog_v1_error_proto_depIdxs,
+		MessageInfos:      file_snyk_errorcatalog_v1_error_proto_msgTypes,
+	}.Build()
+	File_snyk_errorcatalog_v1_error_proto = out.File
+	file_snyk_errorcatalog_v1_error_proto_rawDesc = nil
+	file_snyk_errorcatalog_v1_error_proto_goTypes = nil
+	file_snyk_errorcatalog_v1_error_proto_depIdxs = nil
+}
@@ -67,6 +67,7 @@ type OpenSourceEcosystemsCodes struct {
   IncompatibleTargetFrameworkError string
   PrivateModuleError string
   GoModFileMissingError string
+  // Deprecated: This error has been moved to a more generalized namespace to avoid repetition.  Use SNYK-OS-8004 instead.
   SsoReAuthRequiredError string
   IncompleteProjectError string
   InconsistentVendoringError string
@@ -142,6 +143,131 @@ type OpenSourceEcosystemsCodes struct {
   GemVersionConflictError string
   ReachabilitySettingDisabledError string
   UvNoProjectRootError string
+  DotNet OpenSourceEcosystemsDotNetCodes
+  Go OpenSourceEcosystemsGoCodes
+  Maven OpenSourceEcosystemsMavenCodes
+  NodeJS OpenSourceEcosystemsNodeJSCodes
+  Python OpenSourceEcosystemsPythonCodes
+  Ruby OpenSourceEcosystemsRubyCodes
+  Settings OpenSourceEcosystemsSettingsCodes
+  UV OpenSourceEcosystemsUVCodes
+}
+
+type OpenSourceEcosystemsDotNetCodes struct {
+  UnsupportedManifestFileError string
+  UnsupportedTargetFrameworkError string
+  MissingStaticMainFunctionError string
+  PublishFailedError string
+  FailedToAccessPrivatePackageSourceError string
+  MissingMSBuildConditionError string
+  NoTargetFrameworksFoundError string
+  OutdatedSDKVersionRequestedError string
+  ProjectSkippedAndNotFoundError string
+  NugetDependenciesSpaceLimitExceededError string
+  RestoreFailedError string
+  CpmVersionOverrideError string
+  CpmMissingPackageVersionError string
+  CpmDisabledOrMissingVersionError string
+  IncompatibleTargetFrameworkError string
+}
+
+type OpenSourceEcosystemsGoCodes struct {
+  PrivateModuleError string
+  GoModFileMissingError string
+  // Deprecated: This error has been moved to a more generalized namespace to avoid repetition.  Use SNYK-OS-8004 instead.
+  SsoReAuthRequiredError string
+  IncompleteProjectError string
+  InconsistentVendoringError string
+  UnsupportedExternalFileGenerationSCMError string
+  UnableToAccessPrivateDepsError string
+  UnableToUseCredentialsError string
+  ToolchainNotAvailableError string
+  GolangSpaceLimitExceededError string
+  GolangNoSecureProtocolFoundError string
+  GolangConnectionResetByPeerError string
+  GolangInvalidZipFileError string
+  GolangVersionMismatchError string
+  GolangInvalidGoVersionError string
+  GolangDialTcpTimeoutError string
+  GolangHostKeyVerificationFailedError string
+  GolangMissingModuleDeclarationError string
+  GolangModuleVersionConstraintNotMetError string
+}
+
+type OpenSourceEcosystemsMavenCodes struct {
+  MissingRequirementFromPomError string
+  UnableToResolveValueForPropertyError string
+  UnableToResolveVersionForPropertyError string
+  CyclicPropertyDetectedInPomFileError string
+  UnableToParseXMLError string
+  InvalidCoordinatesError string
+  SkippedGroupError string
+  PomFileNotFoundError string
+  MissingProjectFromPomError string
+  CannotResolveTargetPomFromXmlError string
+  CannotResolveTargetPomFromRepoError string
+  CannotGetBuildFileFromRepoError string
+  CannotCreateGitHostError string
+  NoReleasedVersionForVersionsRangeError string
+  SourceNotSupportedError string
+  TimeoutWhenProcessingTheDepTreeError string
+  CannotReachConfiguredRepositoryError string
+  FailedToBuildMavenProjectError string
+}
+
+type OpenSourceEcosystemsNodeJSCodes struct {
+  NoRepoFoundForTheNPMPackageError string
+  CouldNotParseNPMRegistryURLError string
+  CouldNotFindBrokerURLError string
+  UnableToReplaceBrokerURLError string
+  BadNPMVersionError string
+  UnknownBlobEncodingOnGithubError string
+  NoResultsFromForkerProcessesError string
+  ChildProcessExecutionError string
+  NoValidPackageUpgradesError string
+  NoDependencyUpdatesError string
+  CouldNotParseJSONFileError string
+  Base64EncodeError string
+  Base64DecodeError string
+  MissingSupportedFileError string
+  InvalidConfigurationError string
+  PnpmOutOfSyncError string
+  PnpmUnsupportedLockfileVersionError string
+  YarnPackageNotFoundError string
+  UnableToReachRegistryError string
+  OutdatedYarnLockFileError string
+  PermissionDeniedError string
+}
+
+type OpenSourceEcosystemsPythonCodes struct {
+  UnsupportedRequirementsFileError string
+  TooManyManifestFilesError string
+  FailedToApplyDependencyUpdatesError string
+  PythonPackageNotFoundError string
+  SyntaxIssuesError string
+  PipUnsupportedPythonVersionError string
+  PythonVersionConfictError string
+  PipNoMatchingPythonDistributionError string
+  InstallationFailureError string
+  PipenvUnsupportedPythonVersionError string
+  PipenvNoMatchingPythonDistributionError string
+  PythonDependenciesSpaceLimitExceededError string
+  PythonRequiredPackagesMissingError string
+  PythonFailedToWriteTempFilesError string
+}
+
+type OpenSourceEcosystemsRubyCodes struct {
+  CyclicDependencyDetectedError string
+  GemNotFoundError string
+  GemVersionConflictError string
+}
+
+type OpenSourceEcosystemsSettingsCodes struct {
+  ReachabilitySettingDisabledError string
+}
+
+type OpenSourceEcosystemsUVCodes struct {
+  UvNoProjectRootError string
 }
 
 type PurlVulnerabilityFetchingCodes struct {
@@ -506,6 +632,114 @@ var OpenSourceEcosystems = OpenSourceEcosystemsCodes {
   GemVersionConflictError: "SNYK-OS-RUBY-0003",
   ReachabilitySettingDisabledError: "SNYK-OS-SETTINGS-0001",
   UvNoProjectRootError: "SNYK-OS-UV-0001",
+  DotNet: OpenSourceEcosystemsDotNetCodes {
+    UnsupportedManifestFileError: "SNYK-OS-DOTNET-0001",
+    UnsupportedTargetFrameworkError: "SNYK-OS-DOTNET-0002",
+    MissingStaticMainFunctionError: "SNYK-OS-DOTNET-0003",
+    PublishFailedError: "SNYK-OS-DOTNET-0004",
+    FailedToAccessPrivatePackageSourceError: "SNYK-OS-DOTNET-0005",
+    MissingMSBuildConditionError: "SNYK-OS-DOTNET-0006",
+    NoTargetFrameworksFoundError: "SNYK-OS-DOTNET-0007",
+    OutdatedSDKVersionRequestedError: "SNYK-OS-DOTNET-0008",
+    ProjectSkippedAndNotFoundError: "SNYK-OS-DOTNET-0009",
+    NugetDependenciesSpaceLimitExceededError: "SNYK-OS-DOTNET-0010",
+    RestoreFailedError: "SNYK-OS-DOTNET-0011",
+    CpmVersionOverrideError: "SNYK-OS-DOTNET-0012",
+    CpmMissingPackageVersionError: "SNYK-OS-DOTNET-0013",
+    CpmDisabledOrMissingVersionError: "SNYK-OS-DOTNET-0014",
+    IncompatibleTargetFrameworkError: "SNYK-OS-DOTNET-0015",
+  },
+  Go: OpenSourceEcosystemsGoCodes {
+    PrivateModuleError: "SNYK-OS-GO-0001",
+    GoModFileMissingError: "SNYK-OS-GO-0002",
+    SsoReAuthRequiredError: "SNYK-OS-GO-0003",
+    IncompleteProjectError: "SNYK-OS-GO-0004",
+    InconsistentVendoringError: "SNYK-OS-GO-0005",
+    UnsupportedExternalFileGenerationSCMError: "SNYK-OS-GO-0006",
+    UnableToAccessPrivateDepsError: "SNYK-OS-GO-0007",
+    UnableToUseCredentialsError: "SNYK-OS-GO-0008",
+    ToolchainNotAvailableError: "SNYK-OS-GO-0009",
+    GolangSpaceLimitExceededError: "SNYK-OS-GO-0010",
+    GolangNoSecureProtocolFoundError: "SNYK-OS-GO-0011",
+    GolangConnectionResetByPeerError: "SNYK-OS-GO-0012",
+    GolangInvalidZipFileError: "SNYK-OS-GO-0013",
+    GolangVersionMismatchError: "SNYK-OS-GO-0014",
+    GolangInvalidGoVersionError: "SNYK-OS-GO-0015",
+    GolangDialTcpTimeoutError: "SNYK-OS-GO-0016",
+    GolangHostKeyVerificationFailedError: "SNYK-OS-GO-0017",
+    GolangMissingModuleDeclarationError: "SNYK-OS-GO-0018",
+    GolangModuleVersionConstraintNotMetError: "SNYK-OS-GO-0019",
+  },
+  Maven: OpenSourceEcosystemsMavenCodes {
+    MissingRequirementFromPomError: "SNYK-OS-MAVEN-0001",
+    UnableToResolveValueForPropertyError: "SNYK-OS-MAVEN-0002",
+    UnableToResolveVersionForPropertyError: "SNYK-OS-MAVEN-0003",
+    CyclicPropertyDetectedInPomFileError: "SNYK-OS-MAVEN-0004",
+    UnableToParseXMLError: "SNYK-OS-MAVEN-0005",
+    InvalidCoordinatesError: "SNYK-OS-MAVEN-0006",
+    SkippedGroupError: "SNYK-OS-MAVEN-0007",
+    PomFileNotFoundError: "SNYK-OS-MAVEN-0008",
+    MissingProjectFromPomError: "SNYK-OS-MAVEN-0009",
+    CannotResolveTargetPomFromXmlError: "SNYK-OS-MAVEN-0010",
+    CannotResolveTargetPomFromRepoError: "SNYK-OS-MAVEN-0011",
+    CannotGetBuildFileFromRepoError: "SNYK-OS-MAVEN-0012",
+    CannotCreateGitHostError: "SNYK-OS-MAVEN-0013",
+    NoReleasedVersionForVersionsRangeError: "SNYK-OS-MAVEN-0014",
+    SourceNotSupportedError: "SNYK-OS-MAVEN-0015",
+    TimeoutWhenProcessingTheDepTreeError: "SNYK-OS-MAVEN-0016",
+    CannotReachConfiguredRepositoryError: "SNYK-OS-MAVEN-0017",
+    FailedToBuildMavenProjectError: "SNYK-OS-MAVEN-0018",
+  },
+  NodeJS: OpenSourceEcosystemsNodeJSCodes {
+    NoRepoFoundForTheNPMPackageError: "SNYK-OS-NODEJS-0001",
+    CouldNotParseNPMRegistryURLError: "SNYK-OS-NODEJS-0002",
+    CouldNotFindBrokerURLError: "SNYK-OS-NODEJS-0003",
+    UnableToReplaceBrokerURLError: "SNYK-OS-NODEJS-0004",
+    BadNPMVersionError: "SNYK-OS-NODEJS-0005",
+    UnknownBlobEncodingOnGithubError: "SNYK-OS-NODEJS-0006",
+    NoResultsFromForkerProcessesError: "SNYK-OS-NODEJS-0007",
+    ChildProcessExecutionError: "SNYK-OS-NODEJS-0008",
+    NoValidPackageUpgradesError: "SNYK-OS-NODEJS-0009",
+    NoDependencyUpdatesError: "SNYK-OS-NODEJS-0010",
+    CouldNotParseJSONFileError: "SNYK-OS-NODEJS-0011",
+    Base64EncodeError: "SNYK-OS-NODEJS-0012",
+    Base64DecodeError: "SNYK-OS-NODEJS-0013",
+    MissingSupportedFileError: "SNYK-OS-NODEJS-0014",
+    InvalidConfigurationError: "SNYK-OS-NODEJS-0015",
+    PnpmOutOfSyncError: "SNYK-OS-NODEJS-0016",
+    PnpmUnsupportedLockfileVersionError: "SNYK-OS-NODEJS-0017",
+    YarnPackageNotFoundError: "SNYK-OS-NODEJS-0019",
+    UnableToReachRegistryError: "SNYK-OS-NODEJS-0020",
+    OutdatedYarnLockFileError: "SNYK-OS-NODEJS-0021",
+    PermissionDeniedError: "SNYK-OS-NODEJS-0022",
+  },
+  Python: OpenSourceEcosystemsPythonCodes {
+    UnsupportedRequirementsFileError: "SNYK-OS-PYTHON-0001",
+    TooManyManifestFilesError: "SNYK-OS-PYTHON-0002",
+    FailedToApplyDependencyUpdatesError: "SNYK-OS-PYTHON-0003",
+    PythonPackageNotFoundError: "SNYK-OS-PYTHON-0004",
+    SyntaxIssuesError: "SNYK-OS-PYTHON-0005",
+    PipUnsupportedPythonVersionError: "SNYK-OS-PYTHON-0006",
+    PythonVersionConfictError: "SNYK-OS-PYTHON-0007",
+    PipNoMatchingPythonDistributionError: "SNYK-OS-PYTHON-0008",
+    InstallationFailureError: "SNYK-OS-PYTHON-0009",
+    PipenvUnsupportedPythonVersionError: "SNYK-OS-PYTHON-0010",
+    PipenvNoMatchingPythonDistributionError: "SNYK-OS-PYTHON-0011",
+    PythonDependenciesSpaceLimitExceededError: "SNYK-OS-PYTHON-0012",
+    PythonRequiredPackagesMissingError: "SNYK-OS-PYTHON-0013",
+    PythonFailedToWriteTempFilesError: "SNYK-OS-PYTHON-0014",
+  },
+  Ruby: OpenSourceEcosystemsRubyCodes {
+    CyclicDependencyDetectedError: "SNYK-OS-RUBY-0001",
+    GemNotFoundError: "SNYK-OS-RUBY-0002",
+    GemVersionConflictError: "SNYK-OS-RUBY-0003",
+  },
+  Settings: OpenSourceEcosystemsSettingsCodes {
+    ReachabilitySettingDisabledError: "SNYK-OS-SETTINGS-0001",
+  },
+  UV: OpenSourceEcosystemsUVCodes {
+    UvNoProjectRootError: "SNYK-OS-UV-0001",
+  },
 }
 
 var PurlVulnerabilityFetching = PurlVulnerabilityFetchingCodes {
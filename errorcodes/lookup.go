@@ -0,0 +1,68 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package errorcodes
+
+// ErrorCode is an error code of the Error Catalog, for example "SNYK-0001".
+// It is not named Code, as that name is taken by the Snyk Code namespace.
+type ErrorCode string
+
+// Valid reports whether c is an error code of the catalog.
+func (c ErrorCode) Valid() bool {
+	_, ok := names[c]
+	return ok
+}
+
+// Name locates an error code in this package: Namespace is the name of the
+// variable and Field the name of its field holding the code.
+type Name struct {
+	Namespace string
+	Field     string
+}
+
+// NameOf returns the namespace and field name of code, for example "Snyk"
+// and "TooManyRequestsError" for "SNYK-0001". It reports false for codes
+// which are not in the catalog.
+func NameOf(code string) (namespace, field string, ok bool) {
+	name, ok := names[ErrorCode(code)]
+	return name.Namespace, name.Field, ok
+}
+
+// NamespaceOf returns the namespace of code, see NameOf. Codes which are not
+// in the catalog belong to their prefix, see ParsedCode.Prefix, and strings
+// which are not error codes are their own namespace.
+func NamespaceOf(code string) string {
+	if namespace, _, ok := NameOf(code); ok {
+		return namespace
+	}
+
+	if parsed, err := ParseCode(code); err == nil {
+		return parsed.Prefix()
+	}
+
+	return code
+}
+
+// Namespaces returns the names of all namespaces in catalog order.
+func Namespaces() []string {
+	return append([]string(nil), namespaces...)
+}
+
+// Codes returns the error codes of namespace in catalog order, or nil if the
+// namespace does not exist.
+func Codes(namespace string) []ErrorCode {
+	return append([]ErrorCode(nil), codes[namespace]...)
+}
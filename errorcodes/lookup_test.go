@@ -0,0 +1,115 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package errorcodes_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+)
+
+func TestNameOf(t *testing.T) {
+	type test struct {
+		description string
+		code        string
+		namespace   string
+		field       string
+		ok          bool
+	}
+
+	tests := []test{
+		{description: "snyk", code: errorcodes.Snyk.TooManyRequestsError, namespace: "Snyk", field: "TooManyRequestsError", ok: true},
+		{description: "nested namespace", code: "SNYK-OS-GO-0003", namespace: "OpenSourceEcosystems", field: "SsoReAuthRequiredError", ok: true},
+		{description: "code outside of its namespace prefix", code: errorcodes.CLI.ConnectionTimeoutError, namespace: "CLI", field: "ConnectionTimeoutError", ok: true},
+		{description: "unknown", code: "SNYK-0000"},
+		{description: "empty", code: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			namespace, field, ok := errorcodes.NameOf(tt.code)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.namespace, namespace)
+			require.Equal(t, tt.field, field)
+			require.Equal(t, tt.ok, errorcodes.ErrorCode(tt.code).Valid())
+		})
+	}
+}
+
+func TestNamespaceOf(t *testing.T) {
+	require.Equal(t, "Snyk", errorcodes.NamespaceOf("SNYK-0001"))
+	require.Equal(t, "OpenSourceEcosystems", errorcodes.NamespaceOf("SNYK-OS-DOTNET-0001"))
+	require.Equal(t, "CLI", errorcodes.NamespaceOf(errorcodes.CLI.ConnectionTimeoutError))
+	require.Equal(t, "SNYK-OS-DOTNET", errorcodes.NamespaceOf("SNYK-OS-DOTNET-9999"))
+	require.Equal(t, "", errorcodes.NamespaceOf(""))
+	require.Equal(t, "not-a-code", errorcodes.NamespaceOf("not-a-code"))
+}
+
+func TestTables_MatchCatalog(t *testing.T) {
+	entries := catalog.All()
+
+	var namespaces []string
+	codes := make(map[string][]errorcodes.ErrorCode)
+
+	for _, entry := range entries {
+		if len(codes[entry.Namespace]) == 0 {
+			namespaces = append(namespaces, entry.Namespace)
+		}
+		codes[entry.Namespace] = append(codes[entry.Namespace], errorcodes.ErrorCode(entry.Code))
+
+		namespace, field, ok := errorcodes.NameOf(entry.Code)
+		require.True(t, ok, entry.Code)
+		require.Equal(t, entry.Namespace, namespace)
+		require.Equal(t, strings.TrimPrefix(entry.Function, "New"), field)
+	}
+
+	require.Equal(t, namespaces, errorcodes.Namespaces())
+	for _, namespace := range namespaces {
+		require.Equal(t, codes[namespace], errorcodes.Codes(namespace), namespace)
+	}
+}
+
+func TestCodes(t *testing.T) {
+	require.Nil(t, errorcodes.Codes("NoSuchNamespace"))
+
+	codes := errorcodes.Codes("Snyk")
+	require.Equal(t, errorcodes.ErrorCode(errorcodes.Snyk.TooManyRequestsError), codes[0])
+
+	// callers cannot modify the tables
+	codes[0] = "changed"
+	require.Equal(t, errorcodes.ErrorCode(errorcodes.Snyk.TooManyRequestsError), errorcodes.Codes("Snyk")[0])
+
+	namespaces := errorcodes.Namespaces()
+	namespaces[0] = "changed"
+	require.Equal(t, "Snyk", errorcodes.Namespaces()[0])
+}
+
+func BenchmarkNameOf(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		errorcodes.NameOf(errorcodes.OpenSourceEcosystems.UnparseableLockFileError)
+	}
+}
+
+func TestGroups(t *testing.T) {
+	require.Equal(t, "SNYK-OS-GO-0001", errorcodes.OpenSourceEcosystems.Go.PrivateModuleError)
+	require.Equal(t, errorcodes.OpenSourceEcosystems.PrivateModuleError, errorcodes.OpenSourceEcosystems.Go.PrivateModuleError)
+	require.Equal(t, errorcodes.OpenSourceEcosystems.UnsupportedManifestFileError, errorcodes.OpenSourceEcosystems.DotNet.UnsupportedManifestFileError)
+}
@@ -0,0 +1,156 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package errorcodes
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidCode is returned by ParseCode for strings which are not shaped
+// like error codes.
+var ErrInvalidCode = errors.New("invalid error code")
+
+// ParsedCode is an error code split into its parts. Codes consist of a
+// product, namespace segments and a numeric ID joined by dashes, for example:
+//
+//	SNYK-OS-DOTNET-0001       product SNYK, namespace OS DOTNET, ecosystem DOTNET, ID 1
+//	SNYK-UPLOAD-REVISION-0012 product SNYK, namespace UPLOAD REVISION, ID 12
+//	PR-FAILURES-0002          product PR, namespace FAILURES, ID 2
+//	SNYK-0001                 product SNYK, no namespace, ID 1
+type ParsedCode struct {
+	Product   string
+	Namespace []string
+	// Ecosystem is the segment following OS in open source codes, for example
+	// GO in SNYK-OS-GO-0001. It is empty for other codes.
+	Ecosystem string
+	ID        int
+
+	digits int
+}
+
+// ParseCode splits code into its parts. It only validates the format: use
+// ErrorCode.Valid to check whether a code is in the catalog.
+func ParseCode(code string) (ParsedCode, error) {
+	segments := strings.Split(code, "-")
+	if len(segments) < 2 {
+		return ParsedCode{}, fmt.Errorf("%w %q: expected at least a product and an ID", ErrInvalidCode, code)
+	}
+
+	product, id := segments[0], segments[len(segments)-1]
+
+	if !isSegment(product) || strings.ContainsAny(product, "0123456789") {
+		return ParsedCode{}, fmt.Errorf("%w %q: product %q is not upper case letters", ErrInvalidCode, code, product)
+	}
+
+	if len(id) < 3 || len(id) > 4 || strings.Trim(id, "0123456789") != "" {
+		return ParsedCode{}, fmt.Errorf("%w %q: ID %q is not 3 or 4 digits", ErrInvalidCode, code, id)
+	}
+
+	parsed := ParsedCode{Product: product, digits: len(id)}
+	parsed.ID, _ = strconv.Atoi(id)
+
+	for _, segment := range segments[1 : len(segments)-1] {
+		if !isSegment(segment) {
+			return ParsedCode{}, fmt.Errorf("%w %q: segment %q is not upper case letters and digits", ErrInvalidCode, code, segment)
+		}
+		parsed.Namespace = append(parsed.Namespace, segment)
+	}
+
+	if len(parsed.Namespace) > 1 && parsed.Namespace[0] == "OS" {
+		parsed.Ecosystem = parsed.Namespace[1]
+	}
+
+	return parsed, nil
+}
+
+// Parse parses c, see ParseCode.
+func (c ErrorCode) Parse() (ParsedCode, error) {
+	return ParseCode(string(c))
+}
+
+// Prefix returns the product and namespace of the code, for example
+// SNYK-UPLOAD-REVISION for SNYK-UPLOAD-REVISION-0012. Codes of the same
+// service share a prefix.
+func (p ParsedCode) Prefix() string {
+	return strings.Join(append([]string{p.Product}, p.Namespace...), "-")
+}
+
+// String formats the code, keeping the number of digits of the parsed ID.
+func (p ParsedCode) String() string {
+	digits := p.digits
+	if digits == 0 {
+		digits = 4
+	}
+
+	return fmt.Sprintf("%s-%0*d", p.Prefix(), digits, p.ID)
+}
+
+// Compare orders codes by product, then namespace segment by segment, with
+// shorter namespaces first, then by ID. It returns -1, 0 or +1.
+func (p ParsedCode) Compare(other ParsedCode) int {
+	if c := strings.Compare(p.Product, other.Product); c != 0 {
+		return c
+	}
+
+	for i := 0; i < len(p.Namespace) && i < len(other.Namespace); i++ {
+		if c := strings.Compare(p.Namespace[i], other.Namespace[i]); c != 0 {
+			return c
+		}
+	}
+
+	if c := compareInts(len(p.Namespace), len(other.Namespace)); c != 0 {
+		return c
+	}
+
+	return compareInts(p.ID, other.ID)
+}
+
+// SortCodes sorts codes in the order of ParsedCode.Compare.
+func SortCodes(codes []ParsedCode) {
+	sort.SliceStable(codes, func(i, j int) bool {
+		return codes[i].Compare(codes[j]) < 0
+	})
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func isSegment(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,96 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package errorcodes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+)
+
+func TestParseCode(t *testing.T) {
+	type test struct {
+		description string
+		code        string
+		product     string
+		namespace   []string
+		ecosystem   string
+		id          int
+		prefix      string
+	}
+
+	tests := []test{
+		{description: "ecosystem", code: "SNYK-OS-DOTNET-0001", product: "SNYK", namespace: []string{"OS", "DOTNET"}, ecosystem: "DOTNET", id: 1, prefix: "SNYK-OS-DOTNET"},
+		{description: "generic open source", code: "SNYK-OS-7001", product: "SNYK", namespace: []string{"OS"}, id: 7001, prefix: "SNYK-OS"},
+		{description: "nested service", code: "SNYK-OSSI-OSPSS-1001", product: "SNYK", namespace: []string{"OSSI", "OSPSS"}, id: 1001, prefix: "SNYK-OSSI-OSPSS"},
+		{description: "other product", code: "PR-FAILURES-0002", product: "PR", namespace: []string{"FAILURES"}, id: 2, prefix: "PR-FAILURES"},
+		{description: "multi-word service", code: "SNYK-UPLOAD-REVISION-0012", product: "SNYK", namespace: []string{"UPLOAD", "REVISION"}, id: 12, prefix: "SNYK-UPLOAD-REVISION"},
+		{description: "no namespace", code: "SNYK-9999", product: "SNYK", id: 9999, prefix: "SNYK"},
+		{description: "three digits", code: "SNYK-OSJVM-001", product: "SNYK", namespace: []string{"OSJVM"}, id: 1, prefix: "SNYK-OSJVM"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			parsed, err := errorcodes.ParseCode(tt.code)
+			require.NoError(t, err)
+			require.Equal(t, tt.product, parsed.Product)
+			require.Equal(t, tt.namespace, parsed.Namespace)
+			require.Equal(t, tt.ecosystem, parsed.Ecosystem)
+			require.Equal(t, tt.id, parsed.ID)
+			require.Equal(t, tt.prefix, parsed.Prefix())
+			require.Equal(t, tt.code, parsed.String())
+		})
+	}
+}
+
+func TestParseCode_Invalid(t *testing.T) {
+	for _, code := range []string{"", "SNYK", "0001", "SNYK-", "snyk-0001", "SNYK-os-0001", "SNYK-OS--0001", "SNYK-OS-1", "SNYK-OS-00001", "SNYK-OS-00A1", "SNYK1-0001", " SNYK-0001"} {
+		t.Run(code, func(t *testing.T) {
+			_, err := errorcodes.ParseCode(code)
+			require.ErrorIs(t, err, errorcodes.ErrInvalidCode)
+		})
+	}
+}
+
+func TestParseCode_Catalog(t *testing.T) {
+	for _, namespace := range errorcodes.Namespaces() {
+		for _, code := range errorcodes.Codes(namespace) {
+			parsed, err := code.Parse()
+			require.NoError(t, err)
+			require.Equal(t, string(code), parsed.String())
+		}
+	}
+}
+
+func TestSortCodes(t *testing.T) {
+	var codes []errorcodes.ParsedCode
+	for _, code := range []string{"SNYK-OS-GO-0002", "SNYK-OS-0010", "PR-FAILURES-0001", "SNYK-0002", "SNYK-OS-GO-0001", "SNYK-OS-0002", "SNYK-CLI-0001"} {
+		parsed, err := errorcodes.ParseCode(code)
+		require.NoError(t, err)
+		codes = append(codes, parsed)
+	}
+
+	errorcodes.SortCodes(codes)
+
+	var sorted []string
+	for _, code := range codes {
+		sorted = append(sorted, code.String())
+	}
+
+	require.Equal(t, []string{"PR-FAILURES-0001", "SNYK-0002", "SNYK-CLI-0001", "SNYK-OS-0002", "SNYK-OS-0010", "SNYK-OS-GO-0001", "SNYK-OS-GO-0002"}, sorted)
+}
@@ -0,0 +1,694 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package errorcodes
+
+// names maps every error code to its namespace and field.
+var names = map[ErrorCode]Name{
+	"SNYK-0001":                 {Namespace: "Snyk", Field: "TooManyRequestsError"},
+	"SNYK-0002":                 {Namespace: "Snyk", Field: "NotImplementedError"},
+	"SNYK-0003":                 {Namespace: "Snyk", Field: "BadRequestError"},
+	"SNYK-0004":                 {Namespace: "Snyk", Field: "TimeoutError"},
+	"SNYK-0005":                 {Namespace: "Snyk", Field: "UnauthorisedError"},
+	"SNYK-0006":                 {Namespace: "Snyk", Field: "TestLimitReachedError"},
+	"SNYK-0007":                 {Namespace: "Snyk", Field: "TagsForOrganizationWithoutGroupError"},
+	"SNYK-0008":                 {Namespace: "Snyk", Field: "BadGatewayError"},
+	"SNYK-0009":                 {Namespace: "Snyk", Field: "ServiceUnavailableError"},
+	"SNYK-0010":                 {Namespace: "Snyk", Field: "RequirementsNotMetError"},
+	"SNYK-0099":                 {Namespace: "Snyk", Field: "MaintenanceWindowError"},
+	"SNYK-9999":                 {Namespace: "Snyk", Field: "ServerError"},
+	"SNYK-OS-0001":              {Namespace: "OpenSourceEcosystems", Field: "UnparseableManifestError"},
+	"SNYK-OS-0002":              {Namespace: "OpenSourceEcosystems", Field: "UnparseableLockFileError"},
+	"SNYK-OS-0003":              {Namespace: "OpenSourceEcosystems", Field: "UnknownDependencyVersionError"},
+	"SNYK-OS-0004":              {Namespace: "OpenSourceEcosystems", Field: "MissingHeaderError"},
+	"SNYK-OS-0005":              {Namespace: "OpenSourceEcosystems", Field: "MissingPayloadError"},
+	"SNYK-OS-0006":              {Namespace: "OpenSourceEcosystems", Field: "UnprocessableFileError"},
+	"SNYK-OS-0007":              {Namespace: "OpenSourceEcosystems", Field: "CannotGetFileFromSourceError"},
+	"SNYK-OS-0008":              {Namespace: "OpenSourceEcosystems", Field: "MissingEnvironmentVariableError"},
+	"SNYK-OS-0009":              {Namespace: "OpenSourceEcosystems", Field: "BrokeredConnectionNotSupportedError"},
+	"SNYK-OS-0010":              {Namespace: "OpenSourceEcosystems", Field: "GitCloneFailedError"},
+	"SNYK-OS-0011":              {Namespace: "OpenSourceEcosystems", Field: "UnsupportedPlatformError"},
+	"SNYK-OS-0012":              {Namespace: "OpenSourceEcosystems", Field: "EmptyManifestError"},
+	"SNYK-OS-DOTNET-0001":       {Namespace: "OpenSourceEcosystems", Field: "UnsupportedManifestFileError"},
+	"SNYK-OS-DOTNET-0002":       {Namespace: "OpenSourceEcosystems", Field: "UnsupportedTargetFrameworkError"},
+	"SNYK-OS-DOTNET-0003":       {Namespace: "OpenSourceEcosystems", Field: "MissingStaticMainFunctionError"},
+	"SNYK-OS-DOTNET-0004":       {Namespace: "OpenSourceEcosystems", Field: "PublishFailedError"},
+	"SNYK-OS-DOTNET-0005":       {Namespace: "OpenSourceEcosystems", Field: "FailedToAccessPrivatePackageSourceError"},
+	"SNYK-OS-DOTNET-0006":       {Namespace: "OpenSourceEcosystems", Field: "MissingMSBuildConditionError"},
+	"SNYK-OS-DOTNET-0007":       {Namespace: "OpenSourceEcosystems", Field: "NoTargetFrameworksFoundError"},
+	"SNYK-OS-DOTNET-0008":       {Namespace: "OpenSourceEcosystems", Field: "OutdatedSDKVersionRequestedError"},
+	"SNYK-OS-DOTNET-0009":       {Namespace: "OpenSourceEcosystems", Field: "ProjectSkippedAndNotFoundError"},
+	"SNYK-OS-DOTNET-0010":       {Namespace: "OpenSourceEcosystems", Field: "NugetDependenciesSpaceLimitExceededError"},
+	"SNYK-OS-DOTNET-0011":       {Namespace: "OpenSourceEcosystems", Field: "RestoreFailedError"},
+	"SNYK-OS-DOTNET-0012":       {Namespace: "OpenSourceEcosystems", Field: "CpmVersionOverrideError"},
+	"SNYK-OS-DOTNET-0013":       {Namespace: "OpenSourceEcosystems", Field: "CpmMissingPackageVersionError"},
+	"SNYK-OS-DOTNET-0014":       {Namespace: "OpenSourceEcosystems", Field: "CpmDisabledOrMissingVersionError"},
+	"SNYK-OS-DOTNET-0015":       {Namespace: "OpenSourceEcosystems", Field: "IncompatibleTargetFrameworkError"},
+	"SNYK-OS-GO-0001":           {Namespace: "OpenSourceEcosystems", Field: "PrivateModuleError"},
+	"SNYK-OS-GO-0002":           {Namespace: "OpenSourceEcosystems", Field: "GoModFileMissingError"},
+	"SNYK-OS-GO-0003":           {Namespace: "OpenSourceEcosystems", Field: "SsoReAuthRequiredError"},
+	"SNYK-OS-GO-0004":           {Namespace: "OpenSourceEcosystems", Field: "IncompleteProjectError"},
+	"SNYK-OS-GO-0005":           {Namespace: "OpenSourceEcosystems", Field: "InconsistentVendoringError"},
+	"SNYK-OS-GO-0006":           {Namespace: "OpenSourceEcosystems", Field: "UnsupportedExternalFileGenerationSCMError"},
+	"SNYK-OS-GO-0007":           {Namespace: "OpenSourceEcosystems", Field: "UnableToAccessPrivateDepsError"},
+	"SNYK-OS-GO-0008":           {Namespace: "OpenSourceEcosystems", Field: "UnableToUseCredentialsError"},
+	"SNYK-OS-GO-0009":           {Namespace: "OpenSourceEcosystems", Field: "ToolchainNotAvailableError"},
+	"SNYK-OS-GO-0010":           {Namespace: "OpenSourceEcosystems", Field: "GolangSpaceLimitExceededError"},
+	"SNYK-OS-GO-0011":           {Namespace: "OpenSourceEcosystems", Field: "GolangNoSecureProtocolFoundError"},
+	"SNYK-OS-GO-0012":           {Namespace: "OpenSourceEcosystems", Field: "GolangConnectionResetByPeerError"},
+	"SNYK-OS-GO-0013":           {Namespace: "OpenSourceEcosystems", Field: "GolangInvalidZipFileError"},
+	"SNYK-OS-GO-0014":           {Namespace: "OpenSourceEcosystems", Field: "GolangVersionMismatchError"},
+	"SNYK-OS-GO-0015":           {Namespace: "OpenSourceEcosystems", Field: "GolangInvalidGoVersionError"},
+	"SNYK-OS-GO-0016":           {Namespace: "OpenSourceEcosystems", Field: "GolangDialTcpTimeoutError"},
+	"SNYK-OS-GO-0017":           {Namespace: "OpenSourceEcosystems", Field: "GolangHostKeyVerificationFailedError"},
+	"SNYK-OS-GO-0018":           {Namespace: "OpenSourceEcosystems", Field: "GolangMissingModuleDeclarationError"},
+	"SNYK-OS-GO-0019":           {Namespace: "OpenSourceEcosystems", Field: "GolangModuleVersionConstraintNotMetError"},
+	"SNYK-OS-MAVEN-0001":        {Namespace: "OpenSourceEcosystems", Field: "MissingRequirementFromPomError"},
+	"SNYK-OS-MAVEN-0002":        {Namespace: "OpenSourceEcosystems", Field: "UnableToResolveValueForPropertyError"},
+	"SNYK-OS-MAVEN-0003":        {Namespace: "OpenSourceEcosystems", Field: "UnableToResolveVersionForPropertyError"},
+	"SNYK-OS-MAVEN-0004":        {Namespace: "OpenSourceEcosystems", Field: "CyclicPropertyDetectedInPomFileError"},
+	"SNYK-OS-MAVEN-0005":        {Namespace: "OpenSourceEcosystems", Field: "UnableToParseXMLError"},
+	"SNYK-OS-MAVEN-0006":        {Namespace: "OpenSourceEcosystems", Field: "InvalidCoordinatesError"},
+	"SNYK-OS-MAVEN-0007":        {Namespace: "OpenSourceEcosystems", Field: "SkippedGroupError"},
+	"SNYK-OS-MAVEN-0008":        {Namespace: "OpenSourceEcosystems", Field: "PomFileNotFoundError"},
+	"SNYK-OS-MAVEN-0009":        {Namespace: "OpenSourceEcosystems", Field: "MissingProjectFromPomError"},
+	"SNYK-OS-MAVEN-0010":        {Namespace: "OpenSourceEcosystems", Field: "CannotResolveTargetPomFromXmlError"},
+	"SNYK-OS-MAVEN-0011":        {Namespace: "OpenSourceEcosystems", Field: "CannotResolveTargetPomFromRepoError"},
+	"SNYK-OS-MAVEN-0012":        {Namespace: "OpenSourceEcosystems", Field: "CannotGetBuildFileFromRepoError"},
+	"SNYK-OS-MAVEN-0013":        {Namespace: "OpenSourceEcosystems", Field: "CannotCreateGitHostError"},
+	"SNYK-OS-MAVEN-0014":        {Namespace: "OpenSourceEcosystems", Field: "NoReleasedVersionForVersionsRangeError"},
+	"SNYK-OS-MAVEN-0015":        {Namespace: "OpenSourceEcosystems", Field: "SourceNotSupportedError"},
+	"SNYK-OS-MAVEN-0016":        {Namespace: "OpenSourceEcosystems", Field: "TimeoutWhenProcessingTheDepTreeError"},
+	"SNYK-OS-MAVEN-0017":        {Namespace: "OpenSourceEcosystems", Field: "CannotReachConfiguredRepositoryError"},
+	"SNYK-OS-MAVEN-0018":        {Namespace: "OpenSourceEcosystems", Field: "FailedToBuildMavenProjectError"},
+	"SNYK-OS-NODEJS-0001":       {Namespace: "OpenSourceEcosystems", Field: "NoRepoFoundForTheNPMPackageError"},
+	"SNYK-OS-NODEJS-0002":       {Namespace: "OpenSourceEcosystems", Field: "CouldNotParseNPMRegistryURLError"},
+	"SNYK-OS-NODEJS-0003":       {Namespace: "OpenSourceEcosystems", Field: "CouldNotFindBrokerURLError"},
+	"SNYK-OS-NODEJS-0004":       {Namespace: "OpenSourceEcosystems", Field: "UnableToReplaceBrokerURLError"},
+	"SNYK-OS-NODEJS-0005":       {Namespace: "OpenSourceEcosystems", Field: "BadNPMVersionError"},
+	"SNYK-OS-NODEJS-0006":       {Namespace: "OpenSourceEcosystems", Field: "UnknownBlobEncodingOnGithubError"},
+	"SNYK-OS-NODEJS-0007":       {Namespace: "OpenSourceEcosystems", Field: "NoResultsFromForkerProcessesError"},
+	"SNYK-OS-NODEJS-0008":       {Namespace: "OpenSourceEcosystems", Field: "ChildProcessExecutionError"},
+	"SNYK-OS-NODEJS-0009":       {Namespace: "OpenSourceEcosystems", Field: "NoValidPackageUpgradesError"},
+	"SNYK-OS-NODEJS-0010":       {Namespace: "OpenSourceEcosystems", Field: "NoDependencyUpdatesError"},
+	"SNYK-OS-NODEJS-0011":       {Namespace: "OpenSourceEcosystems", Field: "CouldNotParseJSONFileError"},
+	"SNYK-OS-NODEJS-0012":       {Namespace: "OpenSourceEcosystems", Field: "Base64EncodeError"},
+	"SNYK-OS-NODEJS-0013":       {Namespace: "OpenSourceEcosystems", Field: "Base64DecodeError"},
+	"SNYK-OS-NODEJS-0014":       {Namespace: "OpenSourceEcosystems", Field: "MissingSupportedFileError"},
+	"SNYK-OS-NODEJS-0015":       {Namespace: "OpenSourceEcosystems", Field: "InvalidConfigurationError"},
+	"SNYK-OS-NODEJS-0016":       {Namespace: "OpenSourceEcosystems", Field: "PnpmOutOfSyncError"},
+	"SNYK-OS-NODEJS-0017":       {Namespace: "OpenSourceEcosystems", Field: "PnpmUnsupportedLockfileVersionError"},
+	"SNYK-OS-NODEJS-0019":       {Namespace: "OpenSourceEcosystems", Field: "YarnPackageNotFoundError"},
+	"SNYK-OS-NODEJS-0020":       {Namespace: "OpenSourceEcosystems", Field: "UnableToReachRegistryError"},
+	"SNYK-OS-NODEJS-0021":       {Namespace: "OpenSourceEcosystems", Field: "OutdatedYarnLockFileError"},
+	"SNYK-OS-NODEJS-0022":       {Namespace: "OpenSourceEcosystems", Field: "PermissionDeniedError"},
+	"SNYK-OS-PYTHON-0001":       {Namespace: "OpenSourceEcosystems", Field: "UnsupportedRequirementsFileError"},
+	"SNYK-OS-PYTHON-0002":       {Namespace: "OpenSourceEcosystems", Field: "TooManyManifestFilesError"},
+	"SNYK-OS-PYTHON-0003":       {Namespace: "OpenSourceEcosystems", Field: "FailedToApplyDependencyUpdatesError"},
+	"SNYK-OS-PYTHON-0004":       {Namespace: "OpenSourceEcosystems", Field: "PythonPackageNotFoundError"},
+	"SNYK-OS-PYTHON-0005":       {Namespace: "OpenSourceEcosystems", Field: "SyntaxIssuesError"},
+	"SNYK-OS-PYTHON-0006":       {Namespace: "OpenSourceEcosystems", Field: "PipUnsupportedPythonVersionError"},
+	"SNYK-OS-PYTHON-0007":       {Namespace: "OpenSourceEcosystems", Field: "PythonVersionConfictError"},
+	"SNYK-OS-PYTHON-0008":       {Namespace: "OpenSourceEcosystems", Field: "PipNoMatchingPythonDistributionError"},
+	"SNYK-OS-PYTHON-0009":       {Namespace: "OpenSourceEcosystems", Field: "InstallationFailureError"},
+	"SNYK-OS-PYTHON-0010":       {Namespace: "OpenSourceEcosystems", Field: "PipenvUnsupportedPythonVersionError"},
+	"SNYK-OS-PYTHON-0011":       {Namespace: "OpenSourceEcosystems", Field: "PipenvNoMatchingPythonDistributionError"},
+	"SNYK-OS-PYTHON-0012":       {Namespace: "OpenSourceEcosystems", Field: "PythonDependenciesSpaceLimitExceededError"},
+	"SNYK-OS-PYTHON-0013":       {Namespace: "OpenSourceEcosystems", Field: "PythonRequiredPackagesMissingError"},
+	"SNYK-OS-PYTHON-0014":       {Namespace: "OpenSourceEcosystems", Field: "PythonFailedToWriteTempFilesError"},
+	"SNYK-OS-RUBY-0001":         {Namespace: "OpenSourceEcosystems", Field: "CyclicDependencyDetectedError"},
+	"SNYK-OS-RUBY-0002":         {Namespace: "OpenSourceEcosystems", Field: "GemNotFoundError"},
+	"SNYK-OS-RUBY-0003":         {Namespace: "OpenSourceEcosystems", Field: "GemVersionConflictError"},
+	"SNYK-OS-SETTINGS-0001":     {Namespace: "OpenSourceEcosystems", Field: "ReachabilitySettingDisabledError"},
+	"SNYK-OS-UV-0001":           {Namespace: "OpenSourceEcosystems", Field: "UvNoProjectRootError"},
+	"SNYK-OSSI-1040":            {Namespace: "PurlVulnerabilityFetching", Field: "OrganizationNotWhitelistedError"},
+	"SNYK-OSSI-1050":            {Namespace: "PurlVulnerabilityFetching", Field: "AuthorizationRequestFailureError"},
+	"SNYK-OSSI-2010":            {Namespace: "PurlVulnerabilityFetching", Field: "InvalidPurlError"},
+	"SNYK-OSSI-2011":            {Namespace: "PurlVulnerabilityFetching", Field: "NamespaceNotProvidedError"},
+	"SNYK-OSSI-2020":            {Namespace: "PurlVulnerabilityFetching", Field: "UnsupportedEcosystemError"},
+	"SNYK-OSSI-2021":            {Namespace: "PurlVulnerabilityFetching", Field: "MissingComponentError"},
+	"SNYK-OSSI-2022":            {Namespace: "PurlVulnerabilityFetching", Field: "ComponentNotSupportedError"},
+	"SNYK-OSSI-2030":            {Namespace: "PurlVulnerabilityFetching", Field: "PackageNotFoundError"},
+	"SNYK-OSSI-2031":            {Namespace: "PurlVulnerabilityFetching", Field: "VulnerabilityServiceUnavailableError"},
+	"SNYK-OSSI-2032":            {Namespace: "PurlVulnerabilityFetching", Field: "VulnDBInvalidResponseError"},
+	"SNYK-OSSI-2033":            {Namespace: "PurlVulnerabilityFetching", Field: "VulndbNextError"},
+	"SNYK-OSSI-2040":            {Namespace: "PurlVulnerabilityFetching", Field: "InternalServerError"},
+	"SNYK-OSSI-2041":            {Namespace: "PurlVulnerabilityFetching", Field: "InvalidPaginationParametersError"},
+	"SNYK-OSSI-2042":            {Namespace: "PurlVulnerabilityFetching", Field: "TooManyPurlsError"},
+	"SNYK-OSSI-2043":            {Namespace: "PurlVulnerabilityFetching", Field: "TooManyIssuesError"},
+	"SNYK-OSSI-2044":            {Namespace: "PurlVulnerabilityFetching", Field: "UndefinedContainerDistroError"},
+	"SNYK-OSSI-2045":            {Namespace: "PurlVulnerabilityFetching", Field: "UnsupportedDebianDistroError"},
+	"SNYK-OSSI-2046":            {Namespace: "PurlVulnerabilityFetching", Field: "UndefinedContainerVendorError"},
+	"SNYK-OSSI-2047":            {Namespace: "PurlVulnerabilityFetching", Field: "UnsupportedContainerVendorError"},
+	"SNYK-OSSI-2048":            {Namespace: "PurlVulnerabilityFetching", Field: "UnsupportedAlpineDistroError"},
+	"SNYK-OS-8001":              {Namespace: "IsolatedBuilds", Field: "InvalidRequestError"},
+	"SNYK-OS-8002":              {Namespace: "IsolatedBuilds", Field: "BuildEnvironmentNotFoundError"},
+	"SNYK-OS-8003":              {Namespace: "IsolatedBuilds", Field: "UnsupportedEcosystemError"},
+	"SNYK-OS-8004":              {Namespace: "IsolatedBuilds", Field: "SsoReAuthRequiredError"},
+	"SNYK-OS-8005":              {Namespace: "IsolatedBuilds", Field: "ProjectTooBigError"},
+	"SNYK-OS-8006":              {Namespace: "IsolatedBuilds", Field: "DefaultImageNotFoundError"},
+	"SNYK-OSSI-OSPSS-1001":      {Namespace: "OpenSourceProjectSnapshots", Field: "InvalidRequestError"},
+	"SNYK-OSSI-OSPSS-1002":      {Namespace: "OpenSourceProjectSnapshots", Field: "InvalidResponseError"},
+	"SNYK-OSSI-OSPSS-2001":      {Namespace: "OpenSourceProjectSnapshots", Field: "DataTransformationError"},
+	"SNYK-OSSI-OSPSS-3001":      {Namespace: "OpenSourceProjectSnapshots", Field: "StorageFailureError"},
+	"SNYK-OSSI-OSPSS-4001":      {Namespace: "OpenSourceProjectSnapshots", Field: "InternalServerError"},
+	"SNYK-OSSI-OSPI-1001":       {Namespace: "OpenSourceProjectIssues", Field: "InvalidRequestError"},
+	"SNYK-OSSI-OSPI-1002":       {Namespace: "OpenSourceProjectIssues", Field: "InvalidResponseError"},
+	"SNYK-OSSI-OSPI-2001":       {Namespace: "OpenSourceProjectIssues", Field: "DataTransformationError"},
+	"SNYK-OSSI-OSPI-3001":       {Namespace: "OpenSourceProjectIssues", Field: "StorageFailureError"},
+	"SNYK-OSSI-OSPI-4001":       {Namespace: "OpenSourceProjectIssues", Field: "InternalServerError"},
+	"SNYK-OPENAPI-0001":         {Namespace: "OpenAPI", Field: "BadRequestError"},
+	"SNYK-OPENAPI-0002":         {Namespace: "OpenAPI", Field: "ForbiddenError"},
+	"SNYK-OPENAPI-0003":         {Namespace: "OpenAPI", Field: "NotAcceptableError"},
+	"SNYK-OPENAPI-0004":         {Namespace: "OpenAPI", Field: "NotFoundError"},
+	"SNYK-OPENAPI-0005":         {Namespace: "OpenAPI", Field: "MethodNotAllowedError"},
+	"SNYK-OPENAPI-0006":         {Namespace: "OpenAPI", Field: "RequestEntityTooLargeError"},
+	"SNYK-OPENAPI-0007":         {Namespace: "OpenAPI", Field: "UnauthorizedError"},
+	"SNYK-OPENAPI-0008":         {Namespace: "OpenAPI", Field: "UnsupportedMediaTypeError"},
+	"SNYK-OPENAPI-0009":         {Namespace: "OpenAPI", Field: "ConflictError"},
+	"SNYK-OSJVM-001":            {Namespace: "OpenSourceUnmanaged", Field: "MavenSearchServiceUnavailableError"},
+	"SNYK-OSJVM-002":            {Namespace: "OpenSourceUnmanaged", Field: "Sha1NotFoundError"},
+	"SNYK-OS-9000":              {Namespace: "SbomExport", Field: "InternalServerError"},
+	"SNYK-OS-9001":              {Namespace: "SbomExport", Field: "UnexpectedDepGraphResponseError"},
+	"SNYK-OS-9002":              {Namespace: "SbomExport", Field: "UnexpectedParseDepGraphError"},
+	"SNYK-OS-9003":              {Namespace: "SbomExport", Field: "IaCOrSASTProjectError"},
+	"SNYK-OS-9004":              {Namespace: "SbomExport", Field: "UnsupportedProjectError"},
+	"SNYK-OS-9005":              {Namespace: "SbomExport", Field: "DepGraphResponseError"},
+	"SNYK-OS-9006":              {Namespace: "SbomExport", Field: "MissingAuthTokenError"},
+	"SNYK-OS-9007":              {Namespace: "SbomExport", Field: "EmptyRequestBodyError"},
+	"SNYK-OS-9008":              {Namespace: "SbomExport", Field: "InvalidDepGraphError"},
+	"SNYK-SBOM-0001":            {Namespace: "SbomTest", Field: "InternalError"},
+	"SNYK-SBOM-0002":            {Namespace: "SbomTest", Field: "OrgIDMismatchError"},
+	"SNYK-SBOM-0003":            {Namespace: "SbomTest", Field: "NotFoundError"},
+	"SNYK-SBOM-0004":            {Namespace: "SbomTest", Field: "FailedTestError"},
+	"SNYK-SBOM-0005":            {Namespace: "SbomTest", Field: "PendingTestError"},
+	"SNYK-SBOM-0006":            {Namespace: "SbomTest", Field: "FormatUnknownError"},
+	"SNYK-SBOM-0007":            {Namespace: "SbomTest", Field: "UnprocessableInputError"},
+	"SNYK-SBOM-0008":            {Namespace: "SbomTest", Field: "FormatNotSupportedError"},
+	"SNYK-SBOM-0009":            {Namespace: "SbomTest", Field: "ConversionFailedError"},
+	"SNYK-SBOM-0010":            {Namespace: "SbomTest", Field: "NoTestablePackagesError"},
+	"PR-FAILURES-0001":          {Namespace: "Fix", Field: "FixScenarioNotSupportedError"},
+	"PR-FAILURES-0002":          {Namespace: "Fix", Field: "SCMRateLimitError"},
+	"PR-FAILURES-0003":          {Namespace: "Fix", Field: "UnauthorisedAccessError"},
+	"SNYK-PACKAGES-0001":        {Namespace: "Fix", Field: "UnsupportedEcosystemError"},
+	"SNYK-PACKAGES-0003":        {Namespace: "Fix", Field: "MetadataNotFoundError"},
+	"SNYK-PACKAGES-0005":        {Namespace: "Fix", Field: "NoMatureVersionsFoundError"},
+	"SNYK-PACKAGES-0006":        {Namespace: "Fix", Field: "VersionNotFoundError"},
+	"SNYK-PACKAGES-0007":        {Namespace: "Fix", Field: "AlreadyLatestVersionError"},
+	"SNYK-PACKAGES-0008":        {Namespace: "Fix", Field: "DowngradeVersionUnsupportedError"},
+	"SNYK-PACKAGES-0009":        {Namespace: "Fix", Field: "VersionParsingError"},
+	"SNYK-PR-TEMPLATE-0001":     {Namespace: "Fix", Field: "FailedToGetPullRequestAttributesError"},
+	"SNYK-PR-TEMPLATE-0002":     {Namespace: "Fix", Field: "PullRequestTemplateNotFoundError"},
+	"SNYK-PR-TEMPLATE-0003":     {Namespace: "Fix", Field: "FailedToCompilePrTemplateError"},
+	"SNYK-PR-TEMPLATE-0004":     {Namespace: "Fix", Field: "FailedToParsePullRequestAttributesError"},
+	"SNYK-PR-TEMPLATE-0005":     {Namespace: "Fix", Field: "FailedToLoadCompiledYamlError"},
+	"SNYK-PR-TEMPLATE-0006":     {Namespace: "Fix", Field: "FailedToGenerateHashError"},
+	"SNYK-PR-TEMPLATE-0007":     {Namespace: "Fix", Field: "FailedToCreatePRTemplateError"},
+	"SNYK-PR-TEMPLATE-0008":     {Namespace: "Fix", Field: "FailedToReadPRTemplateError"},
+	"SNYK-PR-TEMPLATE-0009":     {Namespace: "Fix", Field: "FailedToDeletePRTemplateError"},
+	"SNYK-PR-TEMPLATE-0010":     {Namespace: "Fix", Field: "PRTemplateInvalidPayloadError"},
+	"SNYK-PR-TEMPLATE-0011":     {Namespace: "Fix", Field: "FailedToLoadCompiledJSONError"},
+	"SNYK-PR-TEMPLATE-0012":     {Namespace: "Fix", Field: "FailedToRenderDefaultTemplateError"},
+	"SNYK-CODE-0001":            {Namespace: "Code", Field: "AnalysisFileCountLimitExceededError"},
+	"SNYK-CODE-0002":            {Namespace: "Code", Field: "AnalysisResultSizeLimitExceededError"},
+	"SNYK-CODE-0003":            {Namespace: "Code", Field: "AnalysisTargetSizeLimitExceededError"},
+	"SNYK-CODE-0004":            {Namespace: "Code", Field: "AnalysisFileNameLengthLimitExceededError"},
+	"SNYK-CODE-0005":            {Namespace: "Code", Field: "FeatureIsNotEnabledError"},
+	"SNYK-CODE-0006":            {Namespace: "Code", Field: "UnsupportedProjectError"},
+	"SNYK-CODE-0007":            {Namespace: "Code", Field: "RuleExtensionAlreadyExistsForGroupError"},
+	"SNYK-CODE-0008":            {Namespace: "Code", Field: "OrgRelationshipsMustBeUniqueError"},
+	"SNYK-CODE-0009":            {Namespace: "Code", Field: "GroupRelationshipMustBeForAdminGroupError"},
+	"SNYK-CODE-0010":            {Namespace: "Code", Field: "OrgOutsideAdminGroupError"},
+	"SNYK-CODE-0011":            {Namespace: "Code", Field: "RuleExtensionsLimitReachedError"},
+	"SNYK-CODE-0012":            {Namespace: "Code", Field: "TestRuleExtensionAlreadyPublishedForGroupError"},
+	"SNYK-CODE-0013":            {Namespace: "Code", Field: "TestIDNotFoundError"},
+	"SNYK-CODE-0014":            {Namespace: "Code", Field: "TestResultsExpiredError"},
+	"SNYK-PR-CHECK-0001":        {Namespace: "PRChecks", Field: "FailedToReadManifestError"},
+	"SNYK-PR-CHECK-0002":        {Namespace: "PRChecks", Field: "ManifestNotFoundError"},
+	"SNYK-PR-CHECK-0003":        {Namespace: "PRChecks", Field: "ThirdPartyRateLimitError"},
+	"SNYK-PR-CHECK-0004":        {Namespace: "PRChecks", Field: "OutOfSyncError"},
+	"SNYK-PR-CHECK-0005":        {Namespace: "PRChecks", Field: "FailedDeterminingProjectTargetError"},
+	"SNYK-PR-CHECK-0006":        {Namespace: "PRChecks", Field: "FailedToCompleteTestError"},
+	"SNYK-PR-CHECK-0007":        {Namespace: "PRChecks", Field: "FailedToFetchMergeCommitShaError"},
+	"SNYK-PR-CHECK-0008":        {Namespace: "PRChecks", Field: "MergeConflictError"},
+	"SNYK-PR-CHECK-0009":        {Namespace: "PRChecks", Field: "FailedToDetectIssuesError"},
+	"SNYK-PR-CHECK-0010":        {Namespace: "PRChecks", Field: "InvalidThirdPartyCredentialsError"},
+	"SNYK-PR-CHECK-0011":        {Namespace: "PRChecks", Field: "FailedToGenerateCommitStatusError"},
+	"SNYK-CLI-0000":             {Namespace: "CLI", Field: "GeneralCLIFailureError"},
+	"SNYK-CLI-0001":             {Namespace: "CLI", Field: "ConfigEnvironmentFailedError"},
+	"SNYK-CLI-0002":             {Namespace: "CLI", Field: "ConfigEnvironmentConsistencyIssueError"},
+	"SNYK-CLI-0003":             {Namespace: "CLI", Field: "EmptyFlagOptionError"},
+	"SNYK-CLI-0004":             {Namespace: "CLI", Field: "InvalidFlagOptionError"},
+	"SNYK-CLI-0005":             {Namespace: "CLI", Field: "GetVulnsFromResourceFailedError"},
+	"SNYK-CLI-0006":             {Namespace: "CLI", Field: "AuthConfigError"},
+	"SNYK-CLI-0007":             {Namespace: "CLI", Field: "CommandArgsError"},
+	"SNYK-CLI-0008":             {Namespace: "CLI", Field: "NoSupportedFilesFoundError"},
+	"SNYK-CLI-0009":             {Namespace: "CLI", Field: "TooManyVulnerablePathsError"},
+	"SNYK-CLI-0010":             {Namespace: "CLI", Field: "ValidationFailureError"},
+	"SNYK-CLI-0011":             {Namespace: "CLI", Field: "GeneralSCAFailureError"},
+	"SNYK-CLI-0012":             {Namespace: "CLI", Field: "GeneralIACFailureError"},
+	"SNYK-CLI-0013":             {Namespace: "CLI", Field: "GeneralSASTFailureError"},
+	"SNYK-CLI-0014":             {Namespace: "CLI", Field: "FeatureUnderDevelopmentError"},
+	"SNYK-CLI-0015":             {Namespace: "CLI", Field: "CommandIsExperimentalError"},
+	"SNYK-CLI-0016":             {Namespace: "CLI", Field: "FeatureNotEnabledError"},
+	"SNYK-CLI-0017":             {Namespace: "CLI", Field: "DNSResolutionError"},
+	"SNYK-CLI-0018":             {Namespace: "CLI", Field: "NetworkTimeoutError"},
+	"SNYK-CLI-0019":             {Namespace: "CLI", Field: "NetworkUnreachableError"},
+	"SNYK-CLI-0020":             {Namespace: "CLI", Field: "TLSCertificateError"},
+	"SNYK-CLI-0021":             {Namespace: "CLI", Field: "ConnectionRefusedError"},
+	"SNYK-CLI-0022":             {Namespace: "CLI", Field: "GenericNetworkError"},
+	"SNYK-CLI-0023":             {Namespace: "CLI", Field: "GeneralSecretsFailureError"},
+	"SNYK-CLI-0024":             {Namespace: "CLI", Field: "DataRenderingError"},
+	"SNYK-CLI-0025":             {Namespace: "CLI", Field: "TerminatedBySignalError"},
+	"SNYK-OS-7001":              {Namespace: "CLI", Field: "ConnectionTimeoutError"},
+	"SNYK-CBI-0001":             {Namespace: "CustomBaseImages", Field: "VersioningSchemaDoesNotSupportTagError"},
+	"SNYK-CBI-0002":             {Namespace: "CustomBaseImages", Field: "RequiredParameterNotProvidedError"},
+	"SNYK-CBI-0003":             {Namespace: "CustomBaseImages", Field: "ProjectDoesNotExistError"},
+	"SNYK-CBI-0004":             {Namespace: "CustomBaseImages", Field: "ProjectIsNotContainerImageError"},
+	"SNYK-CBI-0005":             {Namespace: "CustomBaseImages", Field: "ProjectDoesNotBelongToGroupError"},
+	"SNYK-CBI-0006":             {Namespace: "CustomBaseImages", Field: "RequestIdsDoNotMatchError"},
+	"SNYK-CBI-0007":             {Namespace: "CustomBaseImages", Field: "RequestBodyAttributesMissingError"},
+	"SNYK-CBI-0008":             {Namespace: "CustomBaseImages", Field: "InvalidPaginationCursorError"},
+	"SNYK-CBI-0009":             {Namespace: "CustomBaseImages", Field: "UnableToSortByVersionError"},
+	"SNYK-CBI-0010":             {Namespace: "CustomBaseImages", Field: "UpdateVersioningSchemaFailError"},
+	"SNYK-CBI-0011":             {Namespace: "CustomBaseImages", Field: "ProjectAlreadyLinkedError"},
+	"SNYK-CBI-0012":             {Namespace: "CustomBaseImages", Field: "VersioningSchemaMissingError"},
+	"SNYK-CBI-0013":             {Namespace: "CustomBaseImages", Field: "VersioningSchemaInapplicableError"},
+	"SNYK-CBI-0014":             {Namespace: "CustomBaseImages", Field: "ImageNotFoundError"},
+	"SNYK-CBI-0015":             {Namespace: "CustomBaseImages", Field: "ImageDoesNotExistError"},
+	"SNYK-CBI-0016":             {Namespace: "CustomBaseImages", Field: "ImageUpdateFailedError"},
+	"SNYK-CBI-0017":             {Namespace: "CustomBaseImages", Field: "PropertiesRetrievalFailedError"},
+	"SNYK-CBI-0018":             {Namespace: "CustomBaseImages", Field: "ImageCollectionRetrievalFailedError"},
+	"SNYK-CBI-0019":             {Namespace: "CustomBaseImages", Field: "CreateVersioningSchemaFailError"},
+	"SNYK-INTEGRATION-0001":     {Namespace: "Integration", Field: "IntegrationNotFoundError"},
+	"SNYK-TARGET-0001":          {Namespace: "Target", Field: "TargetNotFoundError"},
+	"SNYK-TARGET-0002":          {Namespace: "Target", Field: "NoUniqueTargetFoundError"},
+	"SNYK-SCM-0001":             {Namespace: "SCM", Field: "UnsupportedIntegrationTypeError"},
+	"SNYK-SCM-0002":             {Namespace: "SCM", Field: "RevisionNotResolvedError"},
+	"SNYK-SCM-0003":             {Namespace: "SCM", Field: "IntegrationAuthenticationFailedError"},
+	"SNYK-SCM-0004":             {Namespace: "SCM", Field: "IntegrationAuthorizationFailedError"},
+	"SNYK-SCM-0005":             {Namespace: "SCM", Field: "FilesLimitExceededError"},
+	"SNYK-SCM-0006":             {Namespace: "SCM", Field: "SizeLimitExceededError"},
+	"SNYK-SCM-0010":             {Namespace: "SCM", Field: "ResourceNotFoundError"},
+	"SNYK-POLICY-0001":          {Namespace: "Policies", Field: "InvalidPolicyApplyError"},
+	"SNYK-AIBOM-0001":           {Namespace: "AiBom", Field: "InternalError"},
+	"SNYK-AIBOM-0002":           {Namespace: "AiBom", Field: "ForbiddenError"},
+	"SNYK-AIBOM-0003":           {Namespace: "AiBom", Field: "NoSupportedFilesError"},
+	"SNYK-UPLOAD-REVISION-0001": {Namespace: "UploadRevision", Field: "UploadRevisionNotFoundError"},
+	"SNYK-UPLOAD-REVISION-0002": {Namespace: "UploadRevision", Field: "UploadRevisionSealedError"},
+	"SNYK-UPLOAD-REVISION-0003": {Namespace: "UploadRevision", Field: "FileTooLargeError"},
+	"SNYK-UPLOAD-REVISION-0004": {Namespace: "UploadRevision", Field: "TotalFilesSizeLimitExceededError"},
+	"SNYK-UPLOAD-REVISION-0005": {Namespace: "UploadRevision", Field: "FileCountLimitExceededError"},
+	"SNYK-UPLOAD-REVISION-0006": {Namespace: "UploadRevision", Field: "FilePathTooLongError"},
+	"SNYK-UPLOAD-REVISION-0007": {Namespace: "UploadRevision", Field: "PopulateRequestLimitExceededError"},
+	"SNYK-UPLOAD-REVISION-0008": {Namespace: "UploadRevision", Field: "TotalUploadRevisionFileCountLimitExceededError"},
+	"SNYK-UPLOAD-REVISION-0009": {Namespace: "UploadRevision", Field: "TotalUploadRevisionSizeLimitExceededError"},
+	"SNYK-UPLOAD-REVISION-0010": {Namespace: "UploadRevision", Field: "UploadRevisionIdMismatchError"},
+	"SNYK-UPLOAD-REVISION-0011": {Namespace: "UploadRevision", Field: "MultipartFieldNameMissingError"},
+	"SNYK-UPLOAD-REVISION-0012": {Namespace: "UploadRevision", Field: "UploadRevisionUnsealedError"},
+}
+
+// namespaces lists the namespaces in catalog order.
+var namespaces = []string{
+	"Snyk",
+	"OpenSourceEcosystems",
+	"PurlVulnerabilityFetching",
+	"IsolatedBuilds",
+	"OpenSourceProjectSnapshots",
+	"OpenSourceProjectIssues",
+	"OpenAPI",
+	"OpenSourceUnmanaged",
+	"SbomExport",
+	"SbomTest",
+	"Fix",
+	"Code",
+	"PRChecks",
+	"CLI",
+	"CustomBaseImages",
+	"Integration",
+	"Target",
+	"SCM",
+	"Policies",
+	"AiBom",
+	"UploadRevision",
+}
+
+// codes lists the error codes of every namespace in catalog order.
+var codes = map[string][]ErrorCode{
+	"Snyk": {
+		"SNYK-0001",
+		"SNYK-0002",
+		"SNYK-0003",
+		"SNYK-0004",
+		"SNYK-0005",
+		"SNYK-0006",
+		"SNYK-0007",
+		"SNYK-0008",
+		"SNYK-0009",
+		"SNYK-0010",
+		"SNYK-0099",
+		"SNYK-9999",
+	},
+	"OpenSourceEcosystems": {
+		"SNYK-OS-0001",
+		"SNYK-OS-0002",
+		"SNYK-OS-0003",
+		"SNYK-OS-0004",
+		"SNYK-OS-0005",
+		"SNYK-OS-0006",
+		"SNYK-OS-0007",
+		"SNYK-OS-0008",
+		"SNYK-OS-0009",
+		"SNYK-OS-0010",
+		"SNYK-OS-0011",
+		"SNYK-OS-0012",
+		"SNYK-OS-DOTNET-0001",
+		"SNYK-OS-DOTNET-0002",
+		"SNYK-OS-DOTNET-0003",
+		"SNYK-OS-DOTNET-0004",
+		"SNYK-OS-DOTNET-0005",
+		"SNYK-OS-DOTNET-0006",
+		"SNYK-OS-DOTNET-0007",
+		"SNYK-OS-DOTNET-0008",
+		"SNYK-OS-DOTNET-0009",
+		"SNYK-OS-DOTNET-0010",
+		"SNYK-OS-DOTNET-0011",
+		"SNYK-OS-DOTNET-0012",
+		"SNYK-OS-DOTNET-0013",
+		"SNYK-OS-DOTNET-0014",
+		"SNYK-OS-DOTNET-0015",
+		"SNYK-OS-GO-0001",
+		"SNYK-OS-GO-0002",
+		"SNYK-OS-GO-0003",
+		"SNYK-OS-GO-0004",
+		"SNYK-OS-GO-0005",
+		"SNYK-OS-GO-0006",
+		"SNYK-OS-GO-0007",
+		"SNYK-OS-GO-0008",
+		"SNYK-OS-GO-0009",
+		"SNYK-OS-GO-0010",
+		"SNYK-OS-GO-0011",
+		"SNYK-OS-GO-0012",
+		"SNYK-OS-GO-0013",
+		"SNYK-OS-GO-0014",
+		"SNYK-OS-GO-0015",
+		"SNYK-OS-GO-0016",
+		"SNYK-OS-GO-0017",
+		"SNYK-OS-GO-0018",
+		"SNYK-OS-GO-0019",
+		"SNYK-OS-MAVEN-0001",
+		"SNYK-OS-MAVEN-0002",
+		"SNYK-OS-MAVEN-0003",
+		"SNYK-OS-MAVEN-0004",
+		"SNYK-OS-MAVEN-0005",
+		"SNYK-OS-MAVEN-0006",
+		"SNYK-OS-MAVEN-0007",
+		"SNYK-OS-MAVEN-0008",
+		"SNYK-OS-MAVEN-0009",
+		"SNYK-OS-MAVEN-0010",
+		"SNYK-OS-MAVEN-0011",
+		"SNYK-OS-MAVEN-0012",
+		"SNYK-OS-MAVEN-0013",
+		"SNYK-OS-MAVEN-0014",
+		"SNYK-OS-MAVEN-0015",
+		"SNYK-OS-MAVEN-0016",
+		"SNYK-OS-MAVEN-0017",
+		"SNYK-OS-MAVEN-0018",
+		"SNYK-OS-NODEJS-0001",
+		"SNYK-OS-NODEJS-0002",
+		"SNYK-OS-NODEJS-0003",
+		"SNYK-OS-NODEJS-0004",
+		"SNYK-OS-NODEJS-0005",
+		"SNYK-OS-NODEJS-0006",
+		"SNYK-OS-NODEJS-0007",
+		"SNYK-OS-NODEJS-0008",
+		"SNYK-OS-NODEJS-0009",
+		"SNYK-OS-NODEJS-0010",
+		"SNYK-OS-NODEJS-0011",
+		"SNYK-OS-NODEJS-0012",
+		"SNYK-OS-NODEJS-0013",
+		"SNYK-OS-NODEJS-0014",
+		"SNYK-OS-NODEJS-0015",
+		"SNYK-OS-NODEJS-0016",
+		"SNYK-OS-NODEJS-0017",
+		"SNYK-OS-NODEJS-0019",
+		"SNYK-OS-NODEJS-0020",
+		"SNYK-OS-NODEJS-0021",
+		"SNYK-OS-NODEJS-0022",
+		"SNYK-OS-PYTHON-0001",
+		"SNYK-OS-PYTHON-0002",
+		"SNYK-OS-PYTHON-0003",
+		"SNYK-OS-PYTHON-0004",
+		"SNYK-OS-PYTHON-0005",
+		"SNYK-OS-PYTHON-0006",
+		"SNYK-OS-PYTHON-0007",
+		"SNYK-OS-PYTHON-0008",
+		"SNYK-OS-PYTHON-0009",
+		"SNYK-OS-PYTHON-0010",
+		"SNYK-OS-PYTHON-0011",
+		"SNYK-OS-PYTHON-0012",
+		"SNYK-OS-PYTHON-0013",
+		"SNYK-OS-PYTHON-0014",
+		"SNYK-OS-RUBY-0001",
+		"SNYK-OS-RUBY-0002",
+		"SNYK-OS-RUBY-0003",
+		"SNYK-OS-SETTINGS-0001",
+		"SNYK-OS-UV-0001",
+	},
+	"PurlVulnerabilityFetching": {
+		"SNYK-OSSI-1040",
+		"SNYK-OSSI-1050",
+		"SNYK-OSSI-2010",
+		"SNYK-OSSI-2011",
+		"SNYK-OSSI-2020",
+		"SNYK-OSSI-2021",
+		"SNYK-OSSI-2022",
+		"SNYK-OSSI-2030",
+		"SNYK-OSSI-2031",
+		"SNYK-OSSI-2032",
+		"SNYK-OSSI-2033",
+		"SNYK-OSSI-2040",
+		"SNYK-OSSI-2041",
+		"SNYK-OSSI-2042",
+		"SNYK-OSSI-2043",
+		"SNYK-OSSI-2044",
+		"SNYK-OSSI-2045",
+		"SNYK-OSSI-2046",
+		"SNYK-OSSI-2047",
+		"SNYK-OSSI-2048",
+	},
+	"IsolatedBuilds": {
+		"SNYK-OS-8001",
+		"SNYK-OS-8002",
+		"SNYK-OS-8003",
+		"SNYK-OS-8004",
+		"SNYK-OS-8005",
+		"SNYK-OS-8006",
+	},
+	"OpenSourceProjectSnapshots": {
+		"SNYK-OSSI-OSPSS-1001",
+		"SNYK-OSSI-OSPSS-1002",
+		"SNYK-OSSI-OSPSS-2001",
+		"SNYK-OSSI-OSPSS-3001",
+		"SNYK-OSSI-OSPSS-4001",
+	},
+	"OpenSourceProjectIssues": {
+		"SNYK-OSSI-OSPI-1001",
+		"SNYK-OSSI-OSPI-1002",
+		"SNYK-OSSI-OSPI-2001",
+		"SNYK-OSSI-OSPI-3001",
+		"SNYK-OSSI-OSPI-4001",
+	},
+	"OpenAPI": {
+		"SNYK-OPENAPI-0001",
+		"SNYK-OPENAPI-0002",
+		"SNYK-OPENAPI-0003",
+		"SNYK-OPENAPI-0004",
+		"SNYK-OPENAPI-0005",
+		"SNYK-OPENAPI-0006",
+		"SNYK-OPENAPI-0007",
+		"SNYK-OPENAPI-0008",
+		"SNYK-OPENAPI-0009",
+	},
+	"OpenSourceUnmanaged": {
+		"SNYK-OSJVM-001",
+		"SNYK-OSJVM-002",
+	},
+	"SbomExport": {
+		"SNYK-OS-9000",
+		"SNYK-OS-9001",
+		"SNYK-OS-9002",
+		"SNYK-OS-9003",
+		"SNYK-OS-9004",
+		"SNYK-OS-9005",
+		"SNYK-OS-9006",
+		"SNYK-OS-9007",
+		"SNYK-OS-9008",
+	},
+	"SbomTest": {
+		"SNYK-SBOM-0001",
+		"SNYK-SBOM-0002",
+		"SNYK-SBOM-0003",
+		"SNYK-SBOM-0004",
+		"SNYK-SBOM-0005",
+		"SNYK-SBOM-0006",
+		"SNYK-SBOM-0007",
+		"SNYK-SBOM-0008",
+		"SNYK-SBOM-0009",
+		"SNYK-SBOM-0010",
+	},
+	"Fix": {
+		"PR-FAILURES-0001",
+		"PR-FAILURES-0002",
+		"PR-FAILURES-0003",
+		"SNYK-PACKAGES-0001",
+		"SNYK-PACKAGES-0003",
+		"SNYK-PACKAGES-0005",
+		"SNYK-PACKAGES-0006",
+		"SNYK-PACKAGES-0007",
+		"SNYK-PACKAGES-0008",
+		"SNYK-PACKAGES-0009",
+		"SNYK-PR-TEMPLATE-0001",
+		"SNYK-PR-TEMPLATE-0002",
+		"SNYK-PR-TEMPLATE-0003",
+		"SNYK-PR-TEMPLATE-0004",
+		"SNYK-PR-TEMPLATE-0005",
+		"SNYK-PR-TEMPLATE-0006",
+		"SNYK-PR-TEMPLATE-0007",
+		"SNYK-PR-TEMPLATE-0008",
+		"SNYK-PR-TEMPLATE-0009",
+		"SNYK-PR-TEMPLATE-0010",
+		"SNYK-PR-TEMPLATE-0011",
+		"SNYK-PR-TEMPLATE-0012",
+	},
+	"Code": {
+		"SNYK-CODE-0001",
+		"SNYK-CODE-0002",
+		"SNYK-CODE-0003",
+		"SNYK-CODE-0004",
+		"SNYK-CODE-0005",
+		"SNYK-CODE-0006",
+		"SNYK-CODE-0007",
+		"SNYK-CODE-0008",
+		"SNYK-CODE-0009",
+		"SNYK-CODE-0010",
+		"SNYK-CODE-0011",
+		"SNYK-CODE-0012",
+		"SNYK-CODE-0013",
+		"SNYK-CODE-0014",
+	},
+	"PRChecks": {
+		"SNYK-PR-CHECK-0001",
+		"SNYK-PR-CHECK-0002",
+		"SNYK-PR-CHECK-0003",
+		"SNYK-PR-CHECK-0004",
+		"SNYK-PR-CHECK-0005",
+		"SNYK-PR-CHECK-0006",
+		"SNYK-PR-CHECK-0007",
+		"SNYK-PR-CHECK-0008",
+		"SNYK-PR-CHECK-0009",
+		"SNYK-PR-CHECK-0010",
+		"SNYK-PR-CHECK-0011",
+	},
+	"CLI": {
+		"SNYK-CLI-0000",
+		"SNYK-CLI-0001",
+		"SNYK-CLI-0002",
+		"SNYK-CLI-0003",
+		"SNYK-CLI-0004",
+		"SNYK-CLI-0005",
+		"SNYK-CLI-0006",
+		"SNYK-CLI-0007",
+		"SNYK-CLI-0008",
+		"SNYK-CLI-0009",
+		"SNYK-CLI-0010",
+		"SNYK-CLI-0011",
+		"SNYK-CLI-0012",
+		"SNYK-CLI-0013",
+		"SNYK-CLI-0014",
+		"SNYK-CLI-0015",
+		"SNYK-CLI-0016",
+		"SNYK-CLI-0017",
+		"SNYK-CLI-0018",
+		"SNYK-CLI-0019",
+		"SNYK-CLI-0020",
+		"SNYK-CLI-0021",
+		"SNYK-CLI-0022",
+		"SNYK-CLI-0023",
+		"SNYK-CLI-0024",
+		"SNYK-CLI-0025",
+		"SNYK-OS-7001",
+	},
+	"CustomBaseImages": {
+		"SNYK-CBI-0001",
+		"SNYK-CBI-0002",
+		"SNYK-CBI-0003",
+		"SNYK-CBI-0004",
+		"SNYK-CBI-0005",
+		"SNYK-CBI-0006",
+		"SNYK-CBI-0007",
+		"SNYK-CBI-0008",
+		"SNYK-CBI-0009",
+		"SNYK-CBI-0010",
+		"SNYK-CBI-0011",
+		"SNYK-CBI-0012",
+		"SNYK-CBI-0013",
+		"SNYK-CBI-0014",
+		"SNYK-CBI-0015",
+		"SNYK-CBI-0016",
+		"SNYK-CBI-0017",
+		"SNYK-CBI-0018",
+		"SNYK-CBI-0019",
+	},
+	"Integration": {
+		"SNYK-INTEGRATION-0001",
+	},
+	"Target": {
+		"SNYK-TARGET-0001",
+		"SNYK-TARGET-0002",
+	},
+	"SCM": {
+		"SNYK-SCM-0001",
+		"SNYK-SCM-0002",
+		"SNYK-SCM-0003",
+		"SNYK-SCM-0004",
+		"SNYK-SCM-0005",
+		"SNYK-SCM-0006",
+		"SNYK-SCM-0010",
+	},
+	"Policies": {
+		"SNYK-POLICY-0001",
+	},
+	"AiBom": {
+		"SNYK-AIBOM-0001",
+		"SNYK-AIBOM-0002",
+		"SNYK-AIBOM-0003",
+	},
+	"UploadRevision": {
+		"SNYK-UPLOAD-REVISION-0001",
+		"SNYK-UPLOAD-REVISION-0002",
+		"SNYK-UPLOAD-REVISION-0003",
+		"SNYK-UPLOAD-REVISION-0004",
+		"SNYK-UPLOAD-REVISION-0005",
+		"SNYK-UPLOAD-REVISION-0006",
+		"SNYK-UPLOAD-REVISION-0007",
+		"SNYK-UPLOAD-REVISION-0008",
+		"SNYK-UPLOAD-REVISION-0009",
+		"SNYK-UPLOAD-REVISION-0010",
+		"SNYK-UPLOAD-REVISION-0011",
+		"SNYK-UPLOAD-REVISION-0012",
+	},
+}
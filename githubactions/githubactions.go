@@ -0,0 +1,134 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package githubactions emits Error Catalog errors as GitHub Actions workflow
+// commands, so that they show up as annotations on the workflow run.
+//
+// Example output:
+//
+//	::group::OpenSourceEcosystems
+//	::error file=go.mod,line=3,title=SNYK-OS-GO-0002::Go mod file not found
+//	::endgroup::
+package githubactions
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// Command maps an Error Catalog level onto a workflow command.
+func Command(level string) string {
+	switch level {
+	case "fatal", "error":
+		return "error"
+	case "warn":
+		return "warning"
+	default:
+		return "notice"
+	}
+}
+
+// Annotation formats err as a single workflow command line, without the
+// trailing newline. The file, line and column are taken from the location
+// recorded in the error meta, see snyk_errors.WithLocation.
+func Annotation(err snyk_errors.Error) string {
+	var properties []string
+
+	if loc, ok := err.Location(); ok && loc.File != "" {
+		properties = append(properties, "file="+escapeProperty(loc.File))
+
+		if loc.Line > 0 {
+			properties = append(properties, "line="+strconv.Itoa(loc.Line))
+		}
+
+		if loc.Column > 0 {
+			properties = append(properties, "col="+strconv.Itoa(loc.Column))
+		}
+	}
+
+	if err.ErrorCode != "" {
+		properties = append(properties, "title="+escapeProperty(err.ErrorCode))
+	}
+
+	command := "::" + Command(err.Level)
+	if len(properties) > 0 {
+		command += " " + strings.Join(properties, ",")
+	}
+
+	return command + "::" + escapeData(message(err))
+}
+
+// Write emits one workflow command per error to w. The commands are grouped
+// by the catalog namespace of the error code, see errorcodes.NamespaceOf, in
+// order of first appearance.
+func Write(w io.Writer, errs []snyk_errors.Error) error {
+	var namespaces []string
+	groups := make(map[string][]string)
+
+	for _, err := range errs {
+		namespace := errorcodes.NamespaceOf(err.ErrorCode)
+		if _, ok := groups[namespace]; !ok {
+			namespaces = append(namespaces, namespace)
+		}
+		groups[namespace] = append(groups[namespace], Annotation(err))
+	}
+
+	var sb strings.Builder
+	for _, namespace := range namespaces {
+		sb.WriteString("::group::" + escapeData(groupName(namespace)) + "\n")
+		for _, annotation := range groups[namespace] {
+			sb.WriteString(annotation + "\n")
+		}
+		sb.WriteString("::endgroup::\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// groupName names the group of errors without an error code.
+func groupName(namespace string) string {
+	if namespace == "" {
+		return "Other"
+	}
+
+	return namespace
+}
+
+func message(err snyk_errors.Error) string {
+	text := err.Title
+	if err.Detail != "" {
+		text += ": " + err.Detail
+	}
+
+	if err.Type != "" {
+		text += "\nSee " + err.Type
+	}
+
+	return text
+}
+
+func escapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+func escapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
@@ -0,0 +1,102 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package githubactions_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/githubactions"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestAnnotation(t *testing.T) {
+	type test struct {
+		description string
+		error       snyk_errors.Error
+		expected    string
+	}
+
+	tests := []test{
+		{
+			description: "without location",
+			error:       snyk_errors.Error{Title: "Unable to parse manifest file", ErrorCode: "SNYK-OS-0001", Level: "error"},
+			expected:    "::error title=SNYK-OS-0001::Unable to parse manifest file",
+		},
+		{
+			description: "with file, line and column",
+			error: snyk_errors.Error{
+				Title:     "Unable to parse manifest file",
+				ErrorCode: "SNYK-OS-0001",
+				Level:     "fatal",
+				Detail:    "unexpected token",
+				Meta:      map[string]any{"file": "src/package.json", "line": 3, "column": 7},
+			},
+			expected: "::error file=src/package.json,line=3,col=7,title=SNYK-OS-0001::Unable to parse manifest file: unexpected token",
+		},
+		{
+			description: "warning with line from JSON",
+			error: snyk_errors.Error{
+				Title:     "Service temporarily throttled",
+				ErrorCode: "SNYK-0001",
+				Level:     "warn",
+				Meta:      map[string]any{"file": "a,b:c.txt", "line": float64(2)},
+			},
+			expected: "::warning file=a%2Cb%3Ac.txt,line=2,title=SNYK-0001::Service temporarily throttled",
+		},
+		{
+			description: "notice with multi-line detail",
+			error:       snyk_errors.Error{Title: "Info", Detail: "100% done\nnext", Type: "https://docs.snyk.io"},
+			expected:    "::notice::Info: 100%25 done%0Anext%0ASee https://docs.snyk.io",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.expected, githubactions.Annotation(tt.error))
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	errs := []snyk_errors.Error{
+		ecosystems.NewGoModFileMissingError("", snyk_errors.WithLocation("services/api/go.mod", 0, 0)),
+		{Title: "Service temporarily throttled", ErrorCode: "SNYK-0001", Level: "warn"},
+		ecosystems.NewPnpmOutOfSyncError(""),
+		{Title: "Unknown", ErrorCode: "SNYK-OS-DOTNET-9999", Level: "error"},
+		{Title: "No code", Level: "info"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, githubactions.Write(&buf, errs))
+
+	require.Equal(t, "::group::OpenSourceEcosystems\n"+
+		"::error file=services/api/go.mod,title=SNYK-OS-GO-0002::Go mod file not found%0ASee https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0002\n"+
+		"::error title=SNYK-OS-NODEJS-0016::"+errs[2].Title+"%0ASee https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0016\n"+
+		"::endgroup::\n"+
+		"::group::Snyk\n"+
+		"::warning title=SNYK-0001::Service temporarily throttled\n"+
+		"::endgroup::\n"+
+		"::group::SNYK-OS-DOTNET\n"+
+		"::error title=SNYK-OS-DOTNET-9999::Unknown\n"+
+		"::endgroup::\n"+
+		"::group::Other\n"+
+		"::notice::No code\n"+
+		"::endgroup::\n", buf.String())
+}
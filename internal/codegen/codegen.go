@@ -0,0 +1,632 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package codegen generates the namespace packages, the errorcodes package, the
+// catalog registry and the English l10n message bundle from the declarative
+// catalog spec in spec/catalog.yaml.
+//
+// The spec is the source of truth of the Error Catalog. To change an error,
+// edit the spec and run go generate ./catalog.
+package codegen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ModulePath is the import path of the module the code is generated into.
+const ModulePath = "github.com/snyk/error-catalog-golang-public"
+
+// DocsURL is the documentation page of the Error Catalog. The Type of an error
+// is this URL with the lowercased error code as anchor.
+const DocsURL = "https://docs.snyk.io/scan-with-snyk/error-catalog"
+
+// ListFile lists the files written by the generator, relative to the module
+// root.
+const ListFile = ".generated"
+
+// MessageBundlePath is the path of the English l10n message bundle, which is
+// generated from the titles and descriptions of the spec.
+const MessageBundlePath = "l10n/locales/en.json"
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"quote":   strconv.Quote,
+	"comment": comment,
+}).ParseFS(templateFS, "templates/*.tmpl"))
+
+// Spec is the declarative description of the Error Catalog.
+type Spec struct {
+	// Version is the semantic version of the catalog, MAJOR.MINOR.PATCH. A
+	// spec without version is 0.0.0.
+	Version    string      `yaml:"version,omitempty"`
+	Namespaces []Namespace `yaml:"namespaces"`
+}
+
+// Namespace groups the errors of a product area. Every namespace is generated
+// into its own package and errorcodes struct.
+type Namespace struct {
+	// Name is the namespace name, also used for the errorcodes variable.
+	Name string `yaml:"name"`
+	// Path is the directory of the namespace package relative to the module
+	// root. Its last element is the package name.
+	Path string `yaml:"path"`
+	// Groups split the errors of the namespace further, for example by
+	// ecosystem. See Group.
+	Groups []Group `yaml:"groups,omitempty"`
+	Errors []Error `yaml:"errors"`
+}
+
+// Group is a part of a namespace generated into its own subpackage and
+// errorcodes struct, which is also a field of the namespace struct. The
+// namespace package keeps a constructor calling the one of the group, and the
+// namespace struct keeps a field for every code, so that existing callers
+// keep working.
+type Group struct {
+	// Name is the name of the field of the group in the namespace struct.
+	Name string `yaml:"name"`
+	// Path is the directory of the group package relative to the module root.
+	Path string `yaml:"path"`
+}
+
+// Error describes a single error code and its constructor.
+type Error struct {
+	Function       string   `yaml:"function"`
+	Code           string   `yaml:"code"`
+	Title          string   `yaml:"title"`
+	Description    string   `yaml:"description"`
+	StatusCode     int      `yaml:"statusCode"`
+	Classification string   `yaml:"classification"`
+	Level          string   `yaml:"level"`
+	Links          []string `yaml:"links,omitempty"`
+	// NonHTTPStatus declares that the error is not tied to an HTTP response,
+	// such as errors raised locally by the CLI. Only such errors have status
+	// code 0, see catalog.NonHTTPStatus.
+	NonHTTPStatus bool `yaml:"nonHTTPStatus,omitempty"`
+	// Legacy is the reason why the published code breaks the format or
+	// prefix rules of catalog.Verify, which skips those checks for it.
+	Legacy string `yaml:"legacy,omitempty"`
+	// LimitDetail is an optional detail template, see
+	// snyk_errors.MustParseDetail. Errors with a limit detail get an additional
+	// constructor taking snyk_errors.LimitParams.
+	LimitDetail string `yaml:"limitDetail,omitempty"`
+	// Deprecated marks the code as retired. Its constructor and errorcodes
+	// fields are documented as deprecated.
+	Deprecated *Deprecation `yaml:"deprecated,omitempty"`
+	// Group is the name of the group of the namespace the error belongs to,
+	// if any.
+	Group string `yaml:"group,omitempty"`
+}
+
+// Deprecation describes when and why an error code was retired.
+type Deprecation struct {
+	// Since is the catalog version which deprecated the code.
+	Since string `yaml:"since"`
+	// ReplacedBy is the code superseding the deprecated one, if any.
+	ReplacedBy string `yaml:"replacedBy,omitempty"`
+	// Notice is the text of the Deprecated paragraph of the constructor
+	// documentation. It defaults to a pointer to the replacement.
+	Notice string `yaml:"notice,omitempty"`
+}
+
+// Package is a generated package of constructors: the package of a namespace
+// with the errors which are not in a group, or the package of a group.
+type Package struct {
+	Namespace string
+	Group     string
+	Path      string
+	Errors    []Error
+}
+
+// ModulePath returns the import path of the module the code is generated into.
+func (s Spec) ModulePath() string {
+	return ModulePath
+}
+
+// Hash returns a short hash of the content of the catalog. It ignores the
+// layout and comments of the spec file, so it only changes along with the
+// generated code.
+func (s Spec) Hash() string {
+	data, err := json.Marshal(s.Namespaces)
+	if err != nil {
+		panic(err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:6])
+}
+
+// CatalogVersion returns the version stamped into the generated code: the
+// semantic version of the spec with the content hash as build metadata, for
+// example 1.0.0+3f2a9c1b4d5e.
+func (s Spec) CatalogVersion() string {
+	version := s.Version
+	if version == "" {
+		version = "0.0.0"
+	}
+
+	return version + "+" + s.Hash()
+}
+
+// Struct returns the name of the errorcodes struct of the namespace.
+func (n Namespace) Struct() string {
+	return n.Name + "Codes"
+}
+
+// Package returns the namespace package, with the errors which are not in a
+// group.
+func (n Namespace) Package() Package {
+	p := Package{Namespace: n.Name, Path: n.Path}
+	for _, e := range n.Errors {
+		if e.Group == "" {
+			p.Errors = append(p.Errors, e)
+		}
+	}
+
+	return p
+}
+
+// GroupPackages returns the packages of the groups of the namespace.
+func (n Namespace) GroupPackages() []Package {
+	packages := make([]Package, 0, len(n.Groups))
+	for _, g := range n.Groups {
+		p := Package{Namespace: n.Name, Group: g.Name, Path: g.Path}
+		for _, e := range n.Errors {
+			if e.Group == g.Name {
+				p.Errors = append(p.Errors, e)
+			}
+		}
+		packages = append(packages, p)
+	}
+
+	return packages
+}
+
+// Packages returns the namespace package followed by the group packages,
+// leaving out packages without errors.
+func (n Namespace) Packages() []Package {
+	var packages []Package
+	for _, p := range append([]Package{n.Package()}, n.GroupPackages()...) {
+		if len(p.Errors) > 0 {
+			packages = append(packages, p)
+		}
+	}
+
+	return packages
+}
+
+// PackageOf returns the package the constructor of e is generated into.
+func (n Namespace) PackageOf(e Error) Package {
+	for _, p := range n.GroupPackages() {
+		if p.Group == e.Group {
+			return p
+		}
+	}
+
+	return n.Package()
+}
+
+// Name returns the package name.
+func (p Package) Name() string {
+	return path.Base(p.Path)
+}
+
+// ImportPath returns the full import path of the package.
+func (p Package) ImportPath() string {
+	return ModulePath + "/" + p.Path
+}
+
+// Struct returns the name of the errorcodes struct of the package.
+func (p Package) Struct() string {
+	return p.Namespace + p.Group + "Codes"
+}
+
+// LimitErrors returns the errors of the package which have a limit detail.
+func (p Package) LimitErrors() []Error {
+	var errs []Error
+	for _, e := range p.Errors {
+		if e.LimitDetail != "" {
+			errs = append(errs, e)
+		}
+	}
+
+	return errs
+}
+
+// Type returns the documentation URL of the error.
+func (e Error) Type() string {
+	return DocsURL + "#" + strings.ToLower(e.Code)
+}
+
+// Field returns the name of the errorcodes struct field of the error.
+func (e Error) Field() string {
+	return strings.TrimPrefix(e.Function, "New")
+}
+
+// DetailVar returns the name of the variable holding the parsed limit detail.
+func (e Error) DetailVar() string {
+	name := strings.TrimSuffix(e.Field(), "Error")
+	return strings.ToLower(name[:1]) + name[1:] + "Detail"
+}
+
+// reserved are the identifiers of the errorcodes package which namespaces
+// cannot use as their name.
+var reserved = map[string]bool{
+	"ErrorCode":  true,
+	"Name":       true,
+	"NameOf":     true,
+	"Namespaces": true,
+	"Codes":      true,
+}
+
+// File is a generated file.
+type File struct {
+	// Path is relative to the module root and uses forward slashes.
+	Path    string
+	Content []byte
+}
+
+// Load reads and validates the spec at path.
+func Load(path string) (Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Spec{}, err
+	}
+
+	return Parse(data)
+}
+
+// Parse decodes and validates a spec.
+func Parse(data []byte) (Spec, error) {
+	var spec Spec
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&spec); err != nil {
+		return Spec{}, fmt.Errorf("parsing spec: %w", err)
+	}
+
+	if err := spec.validate(); err != nil {
+		return Spec{}, err
+	}
+
+	return spec, nil
+}
+
+func (s Spec) validate() error {
+	if _, ok := parseVersion(s.Version); s.Version != "" && !ok {
+		return fmt.Errorf("version %q: not of the form MAJOR.MINOR.PATCH", s.Version)
+	}
+
+	namespaces := make(map[string]bool)
+	paths := make(map[string]bool)
+	codes := make(map[string]string)
+
+	for _, n := range s.Namespaces {
+		switch {
+		case n.Name == "" || n.Path == "":
+			return fmt.Errorf("namespace %q: name and path are required", n.Name)
+		case reserved[n.Name]:
+			return fmt.Errorf("namespace %q: name is reserved in the errorcodes package", n.Name)
+		case namespaces[n.Name]:
+			return fmt.Errorf("namespace %q: declared twice", n.Name)
+		case paths[n.Path]:
+			return fmt.Errorf("namespace %q: path %s is used by another namespace", n.Name, n.Path)
+		}
+		namespaces[n.Name] = true
+		paths[n.Path] = true
+
+		groups := make(map[string]bool)
+		for _, g := range n.Groups {
+			switch {
+			case g.Name == "" || g.Path == "":
+				return fmt.Errorf("namespace %q: group %q: name and path are required", n.Name, g.Name)
+			case groups[g.Name]:
+				return fmt.Errorf("namespace %q: group %q declared twice", n.Name, g.Name)
+			case paths[g.Path]:
+				return fmt.Errorf("namespace %q: group %q: path %s is used by another package", n.Name, g.Name, g.Path)
+			}
+			groups[g.Name] = true
+			paths[g.Path] = true
+		}
+
+		functions := make(map[string]bool)
+		for _, e := range n.Errors {
+			switch {
+			case !strings.HasPrefix(e.Function, "New") || e.Code == "" || e.Title == "":
+				return fmt.Errorf("namespace %q: error %q: function starting with New, code and title are required", n.Name, e.Function)
+			case functions[e.Function]:
+				return fmt.Errorf("namespace %q: function %s declared twice", n.Name, e.Function)
+			case codes[e.Code] != "":
+				return fmt.Errorf("namespace %q: code %s is already used by %s", n.Name, e.Code, codes[e.Code])
+			case e.Group != "" && !groups[e.Group]:
+				return fmt.Errorf("namespace %q: error %s: group %q is not declared", n.Name, e.Function, e.Group)
+			case groups[e.Field()]:
+				return fmt.Errorf("namespace %q: error %s: field %s is also the name of a group", n.Name, e.Function, e.Field())
+			}
+			functions[e.Function] = true
+			codes[e.Code] = n.Name + "." + e.Function
+		}
+	}
+
+	return s.validateDeprecations()
+}
+
+// validateDeprecations checks that replacements exist and that following
+// them always ends at a code which is not replaced, and fills in default
+// notices.
+func (s Spec) validateDeprecations() error {
+	type target struct {
+		pkg      Package
+		function string
+		replaced string
+	}
+
+	version, _ := parseVersion(s.Version)
+
+	targets := make(map[string]target)
+	for _, n := range s.Namespaces {
+		for _, e := range n.Errors {
+			t := target{pkg: n.PackageOf(e), function: e.Function}
+			if e.Deprecated != nil {
+				t.replaced = e.Deprecated.ReplacedBy
+			}
+			targets[e.Code] = t
+		}
+	}
+
+	for _, n := range s.Namespaces {
+		for _, e := range n.Errors {
+			d := e.Deprecated
+			if d == nil {
+				continue
+			}
+
+			since, ok := parseVersion(d.Since)
+			switch {
+			case d.Since == "":
+				return fmt.Errorf("namespace %q: error %s: deprecated without since", n.Name, e.Function)
+			case !ok:
+				return fmt.Errorf("namespace %q: error %s: deprecated since %q, which is not of the form MAJOR.MINOR.PATCH", n.Name, e.Function, d.Since)
+			case s.Version != "" && compareVersions(since, version) > 0:
+				return fmt.Errorf("namespace %q: error %s: deprecated since %s, which is later than the catalog version %s", n.Name, e.Function, d.Since, s.Version)
+			}
+
+			if d.ReplacedBy == "" {
+				continue
+			}
+
+			replacement, ok := targets[d.ReplacedBy]
+			if !ok {
+				return fmt.Errorf("namespace %q: error %s: replacement %s is not in the spec", n.Name, e.Function, d.ReplacedBy)
+			}
+
+			seen := map[string]bool{e.Code: true}
+			for code := d.ReplacedBy; code != ""; code = targets[code].replaced {
+				if seen[code] {
+					return fmt.Errorf("namespace %q: error %s: replacements of %s form a cycle", n.Name, e.Function, e.Code)
+				}
+				seen[code] = true
+			}
+
+			if d.Notice == "" {
+				d.Notice = fmt.Sprintf("Use %s.%s (%s) instead.", replacement.pkg.Name(), replacement.function, d.ReplacedBy)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Generate renders every file generated from spec, sorted by path.
+func Generate(spec Spec) ([]File, error) {
+	var files []File
+
+	add := func(path, name string, data any, gofmt bool) error {
+		var buf bytes.Buffer
+		if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+			return fmt.Errorf("generating %s: %w", path, err)
+		}
+
+		content := buf.Bytes()
+		if gofmt {
+			formatted, err := format.Source(content)
+			if err != nil {
+				return fmt.Errorf("formatting %s: %w", path, err)
+			}
+			content = formatted
+		}
+
+		files = append(files, File{Path: path, Content: content})
+		return nil
+	}
+
+	for _, n := range spec.Namespaces {
+		for _, p := range n.Packages() {
+			if err := add(p.Path+"/"+p.Name()+".go", "namespace.go.tmpl", p, false); err != nil {
+				return nil, err
+			}
+
+			if len(p.LimitErrors()) > 0 {
+				if err := add(p.Path+"/params.go", "params.go.tmpl", p, true); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if len(n.Groups) > 0 {
+			if err := add(n.Path+"/aliases.go", "aliases.go.tmpl", n, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := add("errorcodes/errorcodes.go", "errorcodes.go.tmpl", spec, false); err != nil {
+		return nil, err
+	}
+
+	if err := add("errorcodes/tables.go", "tables.go.tmpl", spec, true); err != nil {
+		return nil, err
+	}
+
+	if err := add("errorcodes/errorcodes_test.go", "errorcodes_test.go.tmpl", spec, false); err != nil {
+		return nil, err
+	}
+
+	if err := add("catalog/registry.go", "registry.go.tmpl", spec, true); err != nil {
+		return nil, err
+	}
+
+	if err := add("snyk_errors/version.go", "version.go.tmpl", spec, true); err != nil {
+		return nil, err
+	}
+
+	bundle, err := messageBundle(spec)
+	if err != nil {
+		return nil, fmt.Errorf("generating %s: %w", MessageBundlePath, err)
+	}
+	files = append(files, File{Path: MessageBundlePath, Content: bundle})
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	return files, nil
+}
+
+// messageBundle renders the titles and descriptions of every error keyed by
+// code, in the format of the l10n message bundles.
+func messageBundle(spec Spec) ([]byte, error) {
+	type message struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}
+
+	bundle := make(map[string]message)
+	for _, n := range spec.Namespaces {
+		for _, e := range n.Errors {
+			bundle[e.Code] = message{Title: e.Title, Description: e.Description}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(bundle); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// List renders the content of ListFile for files.
+func List(files []File) []byte {
+	var buf bytes.Buffer
+	for _, f := range files {
+		buf.WriteString(f.Path + "\n")
+	}
+
+	return buf.Bytes()
+}
+
+// Write writes files and their ListFile below root. Files listed in a previous
+// ListFile which are no longer generated are removed.
+func Write(root string, files []File) error {
+	current := make(map[string]bool, len(files))
+	for _, f := range files {
+		current[f.Path] = true
+	}
+
+	if previous, err := os.ReadFile(filepath.Join(root, ListFile)); err == nil {
+		for _, p := range strings.Fields(string(previous)) {
+			if current[p] {
+				continue
+			}
+			if err := os.Remove(filepath.Join(root, filepath.FromSlash(p))); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	for _, f := range files {
+		p := filepath.Join(root, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(p, f.Content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(filepath.Join(root, ListFile), List(files), 0o644)
+}
+
+// parseVersion parses a MAJOR.MINOR.PATCH version.
+func parseVersion(version string) ([3]int, bool) {
+	var result [3]int
+
+	parts := strings.Split(version, ".")
+	if len(parts) != len(result) {
+		return result, false
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || part != strconv.Itoa(n) {
+			return result, false
+		}
+		result[i] = n
+	}
+
+	return result, true
+}
+
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+
+	return 0
+}
+
+// comment renders text as the lines of a doc comment.
+func comment(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = "// " + line
+	}
+
+	return strings.Join(lines, "\n")
+}
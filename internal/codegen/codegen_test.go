@@ -0,0 +1,422 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package codegen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/internal/codegen"
+)
+
+const root = "../.."
+
+// TestGenerate_MatchesTree proves that the checked-in packages are exactly
+// what the generator produces from the checked-in spec.
+func TestGenerate_MatchesTree(t *testing.T) {
+	spec, err := codegen.Load(filepath.Join(root, "spec", "catalog.yaml"))
+	require.NoError(t, err)
+
+	files, err := codegen.Generate(spec)
+	require.NoError(t, err)
+
+	for _, f := range files {
+		t.Run(f.Path, func(t *testing.T) {
+			expected, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(f.Path)))
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(f.Content), "run go generate ./catalog")
+		})
+	}
+
+	list, err := os.ReadFile(filepath.Join(root, codegen.ListFile))
+	require.NoError(t, err)
+	require.Equal(t, string(list), string(codegen.List(files)))
+}
+
+func TestParse(t *testing.T) {
+	type test struct {
+		description string
+		spec        string
+		err         string
+	}
+
+	tests := []test{
+		{
+			description: "valid",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+`,
+		},
+		{
+			description: "unknown field",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    colour: blue
+`,
+			err: "field colour not found",
+		},
+		{
+			description: "namespace without path",
+			spec: `
+namespaces:
+  - name: Acme
+`,
+			err: `namespace "Acme": name and path are required`,
+		},
+		{
+			description: "reserved namespace name",
+			spec: `
+namespaces:
+  - name: Codes
+    path: codes
+`,
+			err: `namespace "Codes": name is reserved`,
+		},
+		{
+			description: "duplicate namespace",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+  - name: Acme
+    path: acme2
+`,
+			err: `namespace "Acme": declared twice`,
+		},
+		{
+			description: "function without New prefix",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: BrokenError
+        code: ACME-0001
+        title: Broken
+`,
+			err: "function starting with New",
+		},
+		{
+			description: "duplicate code",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+  - name: Other
+    path: other
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+`,
+			err: "code ACME-0001 is already used by Acme.NewBrokenError",
+		},
+		{
+			description: "deprecated without since",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+        deprecated:
+          replacedBy: ACME-0001
+`,
+			err: "error NewBrokenError: deprecated without since",
+		},
+		{
+			description: "unknown replacement",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+        deprecated:
+          since: 1.0.0
+          replacedBy: ACME-0002
+`,
+			err: "error NewBrokenError: replacement ACME-0002 is not in the spec",
+		},
+		{
+			description: "replacement cycle",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+        deprecated:
+          since: 1.0.0
+          replacedBy: ACME-0002
+      - function: NewWobblyError
+        code: ACME-0002
+        title: Wobbly
+        deprecated:
+          since: 1.0.0
+          replacedBy: ACME-0001
+`,
+			err: "replacements of ACME-0001 form a cycle",
+		},
+		{
+			description: "undeclared group",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+        group: Widgets
+`,
+			err: `error NewBrokenError: group "Widgets" is not declared`,
+		},
+		{
+			description: "group path used by a namespace",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    groups:
+      - name: Widgets
+        path: other
+  - name: Other
+    path: other
+`,
+			err: `namespace "Other": path other is used by another namespace`,
+		},
+		{
+			description: "invalid version",
+			spec: `
+version: 1.0
+namespaces: []
+`,
+			err: `version "1.0": not of the form MAJOR.MINOR.PATCH`,
+		},
+		{
+			description: "deprecated after the catalog version",
+			spec: `
+version: 1.2.0
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+        deprecated:
+          since: 1.10.0
+`,
+			err: "deprecated since 1.10.0, which is later than the catalog version 1.2.0",
+		},
+		{
+			description: "group named like a field",
+			spec: `
+namespaces:
+  - name: Acme
+    path: acme
+    groups:
+      - name: BrokenError
+        path: acme/broken
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+`,
+			err: "field BrokenError is also the name of a group",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := codegen.Parse([]byte(tt.spec))
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	spec, err := codegen.Parse([]byte(`
+namespaces:
+  - name: Acme
+    path: acme/widgets
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+        description: The widget is broken.
+        statusCode: 500
+        classification: UNEXPECTED
+        level: error
+`))
+	require.NoError(t, err)
+
+	files, err := codegen.Generate(spec)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	stale := filepath.Join(dir, "old", "old.go")
+	require.NoError(t, os.MkdirAll(filepath.Dir(stale), 0o755))
+	require.NoError(t, os.WriteFile(stale, nil, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, codegen.ListFile), []byte("old/old.go\n"), 0o644))
+
+	require.NoError(t, codegen.Write(dir, files))
+
+	require.NoFileExists(t, stale)
+	for _, f := range files {
+		require.FileExists(t, filepath.Join(dir, filepath.FromSlash(f.Path)))
+	}
+
+	source, err := os.ReadFile(filepath.Join(dir, "acme", "widgets", "widgets.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(source), "package widgets\n")
+	require.Contains(t, string(source), `Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#acme-0001",`)
+
+	list, err := os.ReadFile(filepath.Join(dir, codegen.ListFile))
+	require.NoError(t, err)
+	require.Equal(t, "acme/widgets/widgets.go\ncatalog/registry.go\nerrorcodes/errorcodes.go\nerrorcodes/errorcodes_test.go\nerrorcodes/tables.go\nl10n/locales/en.json\nsnyk_errors/version.go\n", string(list))
+}
+
+func TestGenerate_Groups(t *testing.T) {
+	spec, err := codegen.Parse([]byte(`
+namespaces:
+  - name: Acme
+    path: acme
+    groups:
+      - name: Widgets
+        path: acme/widgets
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+      - function: NewWobblyWidgetError
+        code: ACME-WIDGET-0001
+        title: Wobbly
+        group: Widgets
+`))
+	require.NoError(t, err)
+
+	files, err := codegen.Generate(spec)
+	require.NoError(t, err)
+
+	content := make(map[string]string)
+	for _, f := range files {
+		content[f.Path] = string(f.Content)
+	}
+
+	require.Contains(t, content, "acme/acme.go")
+	require.Contains(t, content["acme/widgets/widgets.go"], "// Package widgets contains errors related to the group Widgets of the namespace\n// Acme of the Error Catalog.")
+	require.Contains(t, content["acme/widgets/widgets.go"], "func NewWobblyWidgetError(")
+	require.NotContains(t, content["acme/acme.go"], "func NewWobblyWidgetError(")
+	require.Contains(t, content["acme/aliases.go"], "return widgets.NewWobblyWidgetError(detail, options...)")
+	require.Contains(t, content["errorcodes/errorcodes.go"], "  WobblyWidgetError string\n  Widgets AcmeWidgetsCodes\n}")
+	require.Contains(t, content["errorcodes/errorcodes.go"], "  Widgets: AcmeWidgetsCodes {\n    WobblyWidgetError: \"ACME-WIDGET-0001\",\n  },")
+	require.Contains(t, content["catalog/registry.go"], `group: "Widgets", pkg: "acme/widgets"`)
+}
+
+func TestParse_DefaultDeprecationNotice(t *testing.T) {
+	spec, err := codegen.Parse([]byte(`
+namespaces:
+  - name: Acme
+    path: acme
+    groups:
+      - name: Widgets
+        path: acme/widgets
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+        deprecated:
+          since: 1.2.0
+          replacedBy: ACME-WIDGET-0001
+      - function: NewBrokenWidgetError
+        code: ACME-WIDGET-0001
+        title: Broken widget
+        group: Widgets
+`))
+	require.NoError(t, err)
+	require.Equal(t, "Use widgets.NewBrokenWidgetError (ACME-WIDGET-0001) instead.", spec.Namespaces[0].Errors[0].Deprecated.Notice)
+
+	files, err := codegen.Generate(spec)
+	require.NoError(t, err)
+
+	for _, f := range files {
+		switch f.Path {
+		case "acme/acme.go":
+			require.Contains(t, string(f.Content), "//\n// Deprecated: Use widgets.NewBrokenWidgetError (ACME-WIDGET-0001) instead.\nfunc NewBrokenError(")
+		case "errorcodes/errorcodes.go":
+			require.Contains(t, string(f.Content), "  // Deprecated: Use widgets.NewBrokenWidgetError (ACME-WIDGET-0001) instead.\n  BrokenError string\n")
+		case "catalog/registry.go":
+			require.Contains(t, string(f.Content), `deprecated: &Deprecation{Since: "1.2.0", ReplacedBy: "ACME-WIDGET-0001"`)
+		}
+	}
+}
+
+func TestSpec_CatalogVersion(t *testing.T) {
+	spec, err := codegen.Parse([]byte(`
+version: 1.2.0
+namespaces:
+  - name: Acme
+    path: acme
+    errors:
+      - function: NewBrokenError
+        code: ACME-0001
+        title: Broken
+`))
+	require.NoError(t, err)
+	require.Regexp(t, `^1\.2\.0\+[0-9a-f]{12}$`, spec.CatalogVersion())
+
+	reformatted, err := codegen.Parse([]byte(`
+# Same catalog, different layout.
+version: 1.2.0
+namespaces:
+  - {name: Acme, path: acme, errors: [{function: NewBrokenError, code: ACME-0001, title: Broken}]}
+`))
+	require.NoError(t, err)
+	require.Equal(t, spec.CatalogVersion(), reformatted.CatalogVersion())
+
+	spec.Namespaces[0].Errors[0].Title = "Broken beyond repair"
+	require.NotEqual(t, reformatted.CatalogVersion(), spec.CatalogVersion())
+
+	unversioned := codegen.Spec{Namespaces: reformatted.Namespaces}
+	require.Equal(t, "0.0.0+"+reformatted.Hash(), unversioned.CatalogVersion())
+}
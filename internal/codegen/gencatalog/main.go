@@ -0,0 +1,47 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command gencatalog generates the namespace packages, the errorcodes package
+// and the catalog registry from the catalog spec. Run it through go generate
+// from the catalog directory.
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/snyk/error-catalog-golang-public/internal/codegen"
+)
+
+func main() {
+	specPath := flag.String("spec", "../spec/catalog.yaml", "path of the catalog spec")
+	root := flag.String("root", "..", "root directory of the module")
+	flag.Parse()
+
+	spec, err := codegen.Load(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	files, err := codegen.Generate(spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := codegen.Write(*root, files); err != nil {
+		log.Fatal(err)
+	}
+}
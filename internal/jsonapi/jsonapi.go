@@ -0,0 +1,53 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package jsonapi declares the JSON:API error documents written and read by
+// package snyk_errors. They live in their own package so that the schema
+// generator can derive the published JSON Schema from them.
+package jsonapi
+
+// Document is a JSON:API error document.
+type Document struct {
+	JSONAPI Object  `json:"jsonapi"`
+	Errors  []Error `json:"errors"`
+}
+
+// Object is the jsonapi member of a document.
+type Object struct {
+	Version string `json:"version"`
+}
+
+// Error is an error object of a document.
+type Error struct {
+	ID     string                 `json:"id,omitempty"`
+	Title  string                 `json:"title,omitempty"`
+	Detail string                 `json:"detail,omitempty"`
+	Status string                 `json:"status,omitempty"`
+	Code   string                 `json:"code,omitempty"`
+	Meta   map[string]interface{} `json:"meta,omitempty"`
+	Links  Links                  `json:"links,omitempty"`
+	Source Source                 `json:"source,omitempty"`
+}
+
+// Links are the links of an error object.
+type Links struct {
+	About string `json:"about,omitempty"`
+}
+
+// Source points at the part of the request which caused an error.
+type Source struct {
+	Pointer string `json:"pointer,omitempty"`
+}
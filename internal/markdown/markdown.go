@@ -0,0 +1,112 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package markdown escapes text for GitHub-flavoured Markdown. It is shared by
+// package render and the errcatalog command, so that errors and catalog
+// exports are escaped alike.
+package markdown
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Escape escapes s so that it is rendered verbatim. Line breaks are
+// folded into spaces.
+func Escape(s string) string {
+	s = EscapeInline(s)
+
+	switch {
+	case s == "":
+		return s
+	case strings.ContainsRune("#+-=", rune(s[0])):
+		return "\\" + s
+	}
+
+	// Ordered list markers, such as "1." or "1)".
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i > 0 && i < len(s) && (s[i] == '.' || s[i] == ')') {
+		return s[:i] + "\\" + s[i:]
+	}
+
+	return s
+}
+
+// EscapeInline backslash-escapes the characters that start inline Markdown
+// constructs, links and HTML. Line breaks are folded into spaces.
+//
+// Mentions and issue references, such as @user, #123 or acme/app#123, would
+// notify users and link issues when posted as a pull request comment. A
+// zero-width joiner after the @ or # keeps them as plain text. E-mail
+// addresses, whose @ follows a word, are not mentions and are kept.
+func EscapeInline(s string) string {
+	var sb strings.Builder
+
+	runes := []rune(strings.Join(strings.Fields(s), " "))
+	for i, r := range runes {
+		if strings.ContainsRune("\\`*_[]<>&|~", r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+
+		if isReference(runes, i) {
+			sb.WriteRune(zeroWidthJoiner)
+		}
+	}
+
+	return sb.String()
+}
+
+const zeroWidthJoiner = '\u200d'
+
+// isReference reports whether the rune at i starts a mention, an @ at the
+// start of a word followed by a name, or an issue reference, a # followed by
+// a number.
+func isReference(runes []rune, i int) bool {
+	if i+1 >= len(runes) {
+		return false
+	}
+
+	next := runes[i+1]
+	switch runes[i] {
+	case '@':
+		atWordStart := i == 0 || !(unicode.IsLetter(runes[i-1]) || unicode.IsDigit(runes[i-1]) || strings.ContainsRune("._-", runes[i-1]))
+		return atWordStart && (unicode.IsLetter(next) || unicode.IsDigit(next))
+	case '#':
+		return unicode.IsDigit(next)
+	default:
+		return false
+	}
+}
+
+// EscapeLines applies escape to each line of s, keeping blank lines as
+// paragraph separators. With hardBreaks the lines within a paragraph are
+// joined with hard line breaks.
+func EscapeLines(s string, escape func(string) string, hardBreaks bool) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+
+	for i, line := range lines {
+		lines[i] = escape(line)
+		if hardBreaks && lines[i] != "" && i < len(lines)-1 && strings.TrimSpace(lines[i+1]) != "" {
+			lines[i] += "\\"
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,36 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package problem declares the problem details documents (RFC 9457) written by
+// package snyk_errors, so that the schema generator can derive the published
+// JSON Schema from them, as for package jsonapi.
+package problem
+
+// Document is a problem details document. The fields of the catalog which
+// are not part of RFC 9457 are extension members.
+type Document struct {
+	Type           string         `json:"type,omitempty"`
+	Title          string         `json:"title"`
+	Status         int            `json:"status,omitempty"`
+	Detail         string         `json:"detail,omitempty"`
+	Instance       string         `json:"instance,omitempty"`
+	ErrorCode      string         `json:"errorCode"`
+	Classification string         `json:"classification"`
+	Level          string         `json:"level"`
+	Links          []string       `json:"links,omitempty"`
+	Logs           []string       `json:"logs,omitempty"`
+	Meta           map[string]any `json:"meta,omitempty"`
+}
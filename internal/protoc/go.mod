@@ -0,0 +1,16 @@
+module github.com/snyk/error-catalog-golang-public/internal/protoc
+
+go 1.20
+
+require (
+	github.com/bufbuild/protocompile v0.6.0
+	github.com/stretchr/testify v1.8.4
+	google.golang.org/protobuf v1.34.2
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sync v0.3.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,143 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command protoc generates Go code for protobuf files with protoc-gen-go, like
+// protoc, so that the generated code can be updated with the Go toolchain
+// alone. It compiles the protobuf files itself and runs the protoc-gen-go
+// plugin pinned by the go.mod of this module, which is separate from the
+// library's so that its dependencies stay out of the library.
+//
+// Usage, from this directory:
+//
+//	go run . -I ../../proto -out ../../errorcatalogpb snyk/errorcatalog/v1/error.proto
+//
+// The generated files are written to the out directory, named after the
+// protobuf files.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// plugin is the command running protoc-gen-go at the version in go.mod.
+var plugin = []string{"go", "run", "google.golang.org/protobuf/cmd/protoc-gen-go"}
+
+func main() {
+	importPath := flag.String("I", ".", "directory the protobuf files are relative to")
+	out := flag.String("out", ".", "directory of the generated files")
+	flag.Parse()
+
+	if err := generate(*importPath, *out, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "protoc: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func generate(importPath, out string, names []string) error {
+	if len(names) == 0 {
+		return fmt.Errorf("no protobuf files given")
+	}
+
+	compiler := protocompile.Compiler{
+		Resolver:       protocompile.WithStandardImports(&protocompile.SourceResolver{ImportPaths: []string{importPath}}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+
+	files, err := compiler.Compile(context.Background(), names...)
+	if err != nil {
+		return err
+	}
+
+	request := &pluginpb.CodeGeneratorRequest{FileToGenerate: names}
+	seen := make(map[string]bool)
+	for _, f := range files {
+		request.ProtoFile = appendWithImports(request.ProtoFile, f, seen)
+	}
+
+	response, err := run(request)
+	if err != nil {
+		return err
+	}
+
+	if response.Error != nil {
+		return fmt.Errorf("%s", response.GetError())
+	}
+
+	for _, f := range response.File {
+		name := filepath.Join(out, path.Base(f.GetName()))
+		if err := os.WriteFile(name, []byte(f.GetContent()), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// run sends request to the plugin over its standard input and reads its
+// response from its standard output, as protoc does.
+func run(request *pluginpb.CodeGeneratorRequest) (*pluginpb.CodeGeneratorResponse, error) {
+	input, err := proto.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command(plugin[0], plugin[1:]...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running protoc-gen-go: %w", err)
+	}
+
+	response := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(stdout.Bytes(), response); err != nil {
+		return nil, fmt.Errorf("reading the response of protoc-gen-go: %w", err)
+	}
+
+	return response, nil
+}
+
+// appendWithImports appends the descriptor of f to protos after the ones of
+// its imports, as the plugin protocol requires.
+func appendWithImports(protos []*descriptorpb.FileDescriptorProto, f protoreflect.FileDescriptor, seen map[string]bool) []*descriptorpb.FileDescriptorProto {
+	if seen[f.Path()] {
+		return protos
+	}
+	seen[f.Path()] = true
+
+	imports := f.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		protos = appendWithImports(protos, imports.Get(i).FileDescriptor, seen)
+	}
+
+	return append(protos, protodesc.ToFileDescriptorProto(f))
+}
+
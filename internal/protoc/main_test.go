@@ -0,0 +1,43 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerate_MatchesTree proves that the checked-in protobuf code is what
+// the generator produces from the checked-in protobuf files.
+func TestGenerate_MatchesTree(t *testing.T) {
+	out := t.TempDir()
+	require.NoError(t, generate("../../proto", out, []string{"snyk/errorcatalog/v1/error.proto"}))
+
+	expected, err := os.ReadFile("../../errorcatalogpb/error.pb.go")
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(filepath.Join(out, "error.pb.go"))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual), "run go generate ./errorcatalogpb")
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	require.ErrorContains(t, generate(".", t.TempDir(), nil), "no protobuf files given")
+	require.Error(t, generate(".", t.TempDir(), []string{"missing.proto"}))
+}
@@ -0,0 +1,117 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schemagen derives JSON Schemas from Go types, following the rules
+// of encoding/json: exported fields named by their json tag, fields tagged
+// with omitempty optional and every other field required.
+//
+// The schemas describe the structure of a type only. Constraints the type
+// system cannot express, such as formats or required map keys, are added to
+// the returned Schema by the caller.
+package schemagen
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Draft is the JSON Schema dialect of the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Const                any                `json:"const,omitempty"`
+	MinLength            int                `json:"minLength,omitempty"`
+	MinItems             int                `json:"minItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+}
+
+// Reflect returns the schema of the JSON encoding of values of type t.
+// Interfaces accept any value.
+func Reflect(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return Reflect(t.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: Reflect(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: Reflect(t.Elem())}
+	case reflect.Struct:
+		return reflectStruct(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func reflectStruct(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		s.Properties[name] = Reflect(field.Type)
+		if !strings.Contains(","+options+",", ",omitempty,") {
+			s.Required = append(s.Required, name)
+		}
+	}
+
+	return s
+}
+
+// Marshal renders s as an indented JSON document.
+func Marshal(s *Schema) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(s); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,74 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package schemagen_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/internal/schemagen"
+)
+
+func TestReflect(t *testing.T) {
+	type inner struct {
+		Enabled bool `json:"enabled"`
+	}
+
+	type value struct {
+		Name     string            `json:"name"`
+		Count    int               `json:"count,omitempty"`
+		Ratio    float64           `json:"ratio,omitempty"`
+		Tags     []string          `json:"tags,omitempty"`
+		Labels   map[string]string `json:"labels,omitempty"`
+		Inner    *inner            `json:"inner,omitempty"`
+		Any      any               `json:"any,omitempty"`
+		Untagged string
+		Skipped  string `json:"-"`
+		private  string
+	}
+
+	require.Equal(t, &schemagen.Schema{
+		Type: "object",
+		Properties: map[string]*schemagen.Schema{
+			"name":   {Type: "string"},
+			"count":  {Type: "integer"},
+			"ratio":  {Type: "number"},
+			"tags":   {Type: "array", Items: &schemagen.Schema{Type: "string"}},
+			"labels": {Type: "object", AdditionalProperties: &schemagen.Schema{Type: "string"}},
+			"inner": {
+				Type:       "object",
+				Properties: map[string]*schemagen.Schema{"enabled": {Type: "boolean"}},
+				Required:   []string{"enabled"},
+			},
+			"any":      {},
+			"Untagged": {Type: "string"},
+		},
+		Required: []string{"name", "Untagged"},
+	}, schemagen.Reflect(reflect.TypeOf(value{private: ""})))
+}
+
+func TestMarshal(t *testing.T) {
+	data, err := schemagen.Marshal(&schemagen.Schema{Schema: schemagen.Draft, Type: "string", Format: "uri-reference"})
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "string",
+  "format": "uri-reference"
+}
+`, string(data))
+}
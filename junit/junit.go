@@ -0,0 +1,133 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package junit writes Error Catalog errors as JUnit XML reports, so that CI
+// systems surface them as failed test cases.
+package junit
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// TestSuites is the root element of a JUnit XML report.
+type TestSuites struct {
+	XMLName  xml.Name    `xml:"testsuites"`
+	Name     string      `xml:"name,attr,omitempty"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Suites   []TestSuite `xml:"testsuite"`
+}
+
+type TestSuite struct {
+	Name      string     `xml:"name,attr"`
+	Tests     int        `xml:"tests,attr"`
+	Failures  int        `xml:"failures,attr"`
+	TestCases []TestCase `xml:"testcase"`
+}
+
+type TestCase struct {
+	Name      string   `xml:"name,attr"`
+	ClassName string   `xml:"classname,attr"`
+	Failure   *Failure `xml:"failure"`
+}
+
+type Failure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Namespace returns the catalog namespace of an error code, see
+// errorcodes.NamespaceOf. For example SNYK-OS-DOTNET-0001 belongs to
+// OpenSourceEcosystems. Unknown codes belong to their prefix.
+func Namespace(code string) string {
+	return errorcodes.NamespaceOf(code)
+}
+
+// NewReport creates a JUnit report named name in which every error is a failed
+// test case. Test suites group the errors by namespace, in order of first
+// appearance.
+func NewReport(name string, errs []snyk_errors.Error) TestSuites {
+	report := TestSuites{Name: name}
+	indexes := make(map[string]int)
+
+	for _, err := range errs {
+		namespace := Namespace(err.ErrorCode)
+
+		index, ok := indexes[namespace]
+		if !ok {
+			index = len(report.Suites)
+			indexes[namespace] = index
+			report.Suites = append(report.Suites, TestSuite{Name: namespace})
+		}
+
+		suite := &report.Suites[index]
+		suite.TestCases = append(suite.TestCases, newTestCase(namespace, err))
+		suite.Tests++
+		suite.Failures++
+		report.Tests++
+		report.Failures++
+	}
+
+	return report
+}
+
+// Write encodes a JUnit report for errs, see NewReport, to w.
+func Write(w io.Writer, name string, errs []snyk_errors.Error) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(NewReport(name, errs)); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func newTestCase(namespace string, err snyk_errors.Error) TestCase {
+	name := err.Title
+	if err.ErrorCode != "" {
+		name = err.ErrorCode + ": " + err.Title
+	}
+
+	var text []string
+	for _, s := range []string{err.Detail, err.Description, err.Type} {
+		if s != "" {
+			text = append(text, s)
+		}
+	}
+	text = append(text, err.Links...)
+
+	return TestCase{
+		Name:      name,
+		ClassName: namespace,
+		Failure: &Failure{
+			Message: err.Title,
+			Type:    err.Level,
+			Text:    strings.Join(text, "\n\n"),
+		},
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package junit_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/junit"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestNamespace(t *testing.T) {
+	require.Equal(t, "OpenSourceEcosystems", junit.Namespace("SNYK-OS-DOTNET-0001"))
+	require.Equal(t, "Snyk", junit.Namespace("SNYK-0001"))
+	require.Equal(t, "CLI", junit.Namespace("SNYK-OS-7001"))
+	require.Equal(t, "SNYK-OS-DOTNET", junit.Namespace("SNYK-OS-DOTNET-9999"))
+	require.Equal(t, "", junit.Namespace(""))
+	require.Equal(t, "not-a-code", junit.Namespace("not-a-code"))
+}
+
+func TestWrite(t *testing.T) {
+	errs := []snyk_errors.Error{
+		{
+			Title:       "Unable to parse manifest file",
+			ErrorCode:   "SNYK-OS-0001",
+			Type:        "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-0001",
+			Level:       "error",
+			Detail:      "unexpected <token> & more",
+			Description: "The provided manifest file could not be parsed.",
+		},
+		{
+			Title:     "Service temporarily throttled",
+			ErrorCode: "SNYK-0001",
+			Level:     "warn",
+		},
+		{
+			Title:     "Unable to parse lock file",
+			ErrorCode: "SNYK-OS-0002",
+			Level:     "error",
+			Links:     []string{"https://snyk.io"},
+		},
+	}
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="snyk test" tests="3" failures="3">
+  <testsuite name="OpenSourceEcosystems" tests="2" failures="2">
+    <testcase name="SNYK-OS-0001: Unable to parse manifest file" classname="OpenSourceEcosystems">
+      <failure message="Unable to parse manifest file" type="error">unexpected &lt;token&gt; &amp; more&#xA;&#xA;The provided manifest file could not be parsed.&#xA;&#xA;https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-0001</failure>
+    </testcase>
+    <testcase name="SNYK-OS-0002: Unable to parse lock file" classname="OpenSourceEcosystems">
+      <failure message="Unable to parse lock file" type="error">https://snyk.io</failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="Snyk" tests="1" failures="1">
+    <testcase name="SNYK-0001: Service temporarily throttled" classname="Snyk">
+      <failure message="Service temporarily throttled" type="warn"></failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`
+
+	var buf bytes.Buffer
+	require.NoError(t, junit.Write(&buf, "snyk test", errs))
+
+	require.Equal(t, expected, buf.String())
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	errs := []snyk_errors.Error{
+		ecosystems.NewUnparseableManifestError("package.json"),
+		ecosystems.NewGoModFileMissingError(""),
+		snyk.NewServerError(""),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, junit.Write(&buf, "snyk test", errs))
+
+	var report junit.TestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &report))
+
+	expected := junit.NewReport("snyk test", errs)
+	expected.XMLName = report.XMLName
+
+	require.Equal(t, expected, report)
+}
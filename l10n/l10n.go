@@ -0,0 +1,229 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package l10n translates the titles and descriptions of Error Catalog errors.
+//
+// Translations are kept in message bundles keyed by error code, one JSON file
+// per language named after its language tag, for example de.json:
+//
+//	{
+//	  "SNYK-0001": {
+//	    "title": "Dienst vorübergehend gedrosselt",
+//	    "description": "Das Limit für die Anfragerate wurde überschritten. ..."
+//	  }
+//	}
+//
+// The bundles shipped with this package are embedded. The English bundle is
+// generated from spec/catalog.yaml by go generate ./catalog. Consumers can
+// load their own bundles, for example from an embed.FS, with NewCatalog.
+package l10n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// DefaultLanguage is the language of the titles and descriptions set by the
+// constructors, and the language used when no better match is available.
+const DefaultLanguage = "en"
+
+//go:embed locales/*.json
+var locales embed.FS
+
+var defaultCatalog = mustLoadDefault()
+
+// Message is the translated title and description of an error code.
+type Message struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// Bundle holds the messages of a single language keyed by error code.
+type Bundle map[string]Message
+
+// Catalog holds a bundle per language.
+type Catalog struct {
+	bundles map[string]Bundle
+}
+
+// NewCatalog loads every <language>.json file found in dir of fsys. A bundle
+// for DefaultLanguage is required, as it is the reference used by Missing.
+func NewCatalog(fsys fs.FS, dir string) (*Catalog, error) {
+	paths, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Catalog{bundles: make(map[string]Bundle)}
+
+	for _, p := range paths {
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return nil, err
+		}
+
+		var bundle Bundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			return nil, fmt.Errorf("parsing message bundle %s: %w", p, err)
+		}
+
+		c.bundles[normalize(strings.TrimSuffix(path.Base(p), ".json"))] = bundle
+	}
+
+	if _, ok := c.bundles[DefaultLanguage]; !ok {
+		return nil, fmt.Errorf("no message bundle for %q in %s", DefaultLanguage, dir)
+	}
+
+	return c, nil
+}
+
+// Default returns the catalog of the bundles embedded in this package.
+func Default() *Catalog {
+	return defaultCatalog
+}
+
+// Languages returns the language tags which have a bundle, sorted.
+func (c *Catalog) Languages() []string {
+	languages := make([]string, 0, len(c.bundles))
+	for language := range c.bundles {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	return languages
+}
+
+// Match returns the language with a bundle which best matches the language
+// tag lang. It tries the full tag, then its primary language subtag, and
+// falls back to DefaultLanguage. For example "de-CH" matches "de".
+func (c *Catalog) Match(lang string) string {
+	lang = normalize(lang)
+
+	for lang != "" {
+		if _, ok := c.bundles[lang]; ok {
+			return lang
+		}
+
+		i := strings.LastIndex(lang, "-")
+		if i < 0 {
+			break
+		}
+		lang = lang[:i]
+	}
+
+	return DefaultLanguage
+}
+
+// Lookup returns the message for code in the language best matching lang.
+// Fields missing from that bundle are taken from DefaultLanguage.
+func (c *Catalog) Lookup(code, lang string) (Message, bool) {
+	fallback, ok := c.bundles[DefaultLanguage][code]
+
+	msg, found := c.bundles[c.Match(lang)][code]
+	if !found {
+		return fallback, ok
+	}
+
+	if msg.Title == "" {
+		msg.Title = fallback.Title
+	}
+
+	if msg.Description == "" {
+		msg.Description = fallback.Description
+	}
+
+	return msg, true
+}
+
+// Localize returns a copy of err with its title and description translated
+// into the language best matching lang. Errors with codes unknown to the
+// catalog are returned unchanged.
+func (c *Catalog) Localize(err snyk_errors.Error, lang string) snyk_errors.Error {
+	msg, ok := c.Lookup(err.ErrorCode, lang)
+	if !ok {
+		return err
+	}
+
+	if msg.Title != "" {
+		err.Title = msg.Title
+	}
+
+	if msg.Description != "" {
+		err.Description = msg.Description
+	}
+
+	return err
+}
+
+// Missing returns the codes of the DefaultLanguage bundle which have no
+// complete translation in the bundle for lang, sorted. All codes are missing
+// if there is no bundle for lang.
+func (c *Catalog) Missing(lang string) []string {
+	bundle := c.bundles[normalize(lang)]
+
+	var missing []string
+	for code := range c.bundles[DefaultLanguage] {
+		if msg, ok := bundle[code]; !ok || msg.Title == "" || msg.Description == "" {
+			missing = append(missing, code)
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
+
+// Localize translates err using the default catalog, see Catalog.Localize.
+func Localize(err snyk_errors.Error, lang string) snyk_errors.Error {
+	return defaultCatalog.Localize(err, lang)
+}
+
+// Missing reports the codes without translation in the default catalog, see
+// Catalog.Missing.
+func Missing(lang string) []string {
+	return defaultCatalog.Missing(lang)
+}
+
+// MarshalToJSONAPIError encodes err as a JSON:API error document, like
+// snyk_errors.Error.MarshalToJSONAPIError, after translating it into lang.
+func MarshalToJSONAPIError(w io.Writer, err snyk_errors.Error, instance, lang string) error {
+	return Localize(err, lang).MarshalToJSONAPIError(w, instance)
+}
+
+// EncodeJSON encodes err as JSON after translating it into lang.
+func EncodeJSON(w io.Writer, err snyk_errors.Error, lang string) error {
+	return json.NewEncoder(w).Encode(Localize(err, lang))
+}
+
+func normalize(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+}
+
+func mustLoadDefault() *Catalog {
+	c, err := NewCatalog(locales, "locales")
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
@@ -0,0 +1,142 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package l10n_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/cli"
+	"github.com/snyk/error-catalog-golang-public/l10n"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestLocalize(t *testing.T) {
+	err := snyk.NewTooManyRequestsError("detail")
+
+	type test struct {
+		description string
+		lang        string
+		title       string
+	}
+
+	tests := []test{
+		{description: "german", lang: "de", title: "Dienst vorübergehend gedrosselt"},
+		{description: "german with region", lang: "de_CH", title: "Dienst vorübergehend gedrosselt"},
+		{description: "japanese", lang: "ja-JP", title: "サービスが一時的に制限されています"},
+		{description: "english", lang: "en", title: "Service temporarily throttled"},
+		{description: "unknown language falls back to english", lang: "fr", title: "Service temporarily throttled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			localized := l10n.Localize(err, tt.lang)
+
+			require.Equal(t, tt.title, localized.Title)
+			require.Equal(t, err.ErrorCode, localized.ErrorCode)
+			require.Equal(t, err.Detail, localized.Detail)
+		})
+	}
+
+	require.Equal(t, "Service temporarily throttled", err.Title, "the original error is not modified")
+}
+
+func TestLocalizeFallsBackToEnglishForMissingCodes(t *testing.T) {
+	err := cli.NewDNSResolutionError("")
+
+	require.Equal(t, err, l10n.Localize(err, "de"))
+}
+
+func TestLocalizeUnknownCode(t *testing.T) {
+	err := snyk_errors.Error{Title: "title", ErrorCode: "UNKNOWN-0001"}
+
+	require.Equal(t, err, l10n.Localize(err, "de"))
+}
+
+func TestEnglishBundleMatchesCatalog(t *testing.T) {
+	for _, entry := range catalog.All() {
+		msg, ok := l10n.Default().Lookup(entry.Code, l10n.DefaultLanguage)
+		require.True(t, ok, entry.Code)
+		require.Equal(t, l10n.Message{Title: entry.Title, Description: entry.Description}, msg, "run go generate ./catalog")
+	}
+}
+
+func TestDefaultCatalog(t *testing.T) {
+	c := l10n.Default()
+
+	require.Equal(t, []string{"de", "en", "ja"}, c.Languages())
+	require.Empty(t, c.Missing(l10n.DefaultLanguage))
+}
+
+// TestMissingTranslations reports the codes which still need a translation.
+func TestMissingTranslations(t *testing.T) {
+	for _, lang := range l10n.Default().Languages() {
+		if missing := l10n.Missing(lang); len(missing) > 0 {
+			t.Logf("%s: %d codes without translation", lang, len(missing))
+		}
+	}
+
+	require.NotContains(t, l10n.Missing("de"), "SNYK-0001")
+	require.Contains(t, l10n.Missing("de"), "SNYK-CLI-0017")
+}
+
+func TestNewCatalog(t *testing.T) {
+	fsys := fstest.MapFS{
+		"bundles/en.json":    {Data: []byte(`{"SNYK-0001": {"title": "Throttled", "description": "Wait."}}`)},
+		"bundles/pt-BR.json": {Data: []byte(`{"SNYK-0001": {"title": "Limitado"}}`)},
+	}
+
+	c, err := l10n.NewCatalog(fsys, "bundles")
+	require.NoError(t, err)
+
+	require.Equal(t, "pt-br", c.Match("pt_BR"))
+	require.Equal(t, "en", c.Match("pt"))
+
+	msg, ok := c.Lookup("SNYK-0001", "pt-BR")
+	require.True(t, ok)
+	require.Equal(t, l10n.Message{Title: "Limitado", Description: "Wait."}, msg)
+	require.Equal(t, []string{"SNYK-0001"}, c.Missing("pt-BR"))
+}
+
+func TestNewCatalogRequiresDefaultLanguage(t *testing.T) {
+	_, err := l10n.NewCatalog(fstest.MapFS{"de.json": {Data: []byte(`{}`)}}, ".")
+
+	require.ErrorContains(t, err, "no message bundle")
+}
+
+func TestMarshalToJSONAPIError(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, l10n.MarshalToJSONAPIError(&buf, snyk.NewServerError(""), "instance", "ja"))
+
+	errs, err := snyk_errors.FromJSONAPIErrorBytes(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, "リクエストを処理できません", errs[0].Title)
+}
+
+func TestEncodeJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, l10n.EncodeJSON(&buf, snyk.NewServerError(""), "de"))
+
+	var actual snyk_errors.Error
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &actual))
+	require.Equal(t, "Anfrage kann nicht verarbeitet werden", actual.Title)
+}
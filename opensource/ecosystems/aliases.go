@@ -0,0 +1,491 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ecosystems
+
+import (
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/dotnet"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/golang"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/maven"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/nodejs"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/python"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/ruby"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/settings"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/uv"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// NewUnsupportedManifestFileError calls dotnet.NewUnsupportedManifestFileError, for existing callers of this package.
+func NewUnsupportedManifestFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewUnsupportedManifestFileError(detail, options...)
+}
+
+// NewUnsupportedTargetFrameworkError calls dotnet.NewUnsupportedTargetFrameworkError, for existing callers of this package.
+func NewUnsupportedTargetFrameworkError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewUnsupportedTargetFrameworkError(detail, options...)
+}
+
+// NewMissingStaticMainFunctionError calls dotnet.NewMissingStaticMainFunctionError, for existing callers of this package.
+func NewMissingStaticMainFunctionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewMissingStaticMainFunctionError(detail, options...)
+}
+
+// NewPublishFailedError calls dotnet.NewPublishFailedError, for existing callers of this package.
+func NewPublishFailedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewPublishFailedError(detail, options...)
+}
+
+// NewFailedToAccessPrivatePackageSourceError calls dotnet.NewFailedToAccessPrivatePackageSourceError, for existing callers of this package.
+func NewFailedToAccessPrivatePackageSourceError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewFailedToAccessPrivatePackageSourceError(detail, options...)
+}
+
+// NewMissingMSBuildConditionError calls dotnet.NewMissingMSBuildConditionError, for existing callers of this package.
+func NewMissingMSBuildConditionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewMissingMSBuildConditionError(detail, options...)
+}
+
+// NewNoTargetFrameworksFoundError calls dotnet.NewNoTargetFrameworksFoundError, for existing callers of this package.
+func NewNoTargetFrameworksFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewNoTargetFrameworksFoundError(detail, options...)
+}
+
+// NewOutdatedSDKVersionRequestedError calls dotnet.NewOutdatedSDKVersionRequestedError, for existing callers of this package.
+func NewOutdatedSDKVersionRequestedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewOutdatedSDKVersionRequestedError(detail, options...)
+}
+
+// NewProjectSkippedAndNotFoundError calls dotnet.NewProjectSkippedAndNotFoundError, for existing callers of this package.
+func NewProjectSkippedAndNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewProjectSkippedAndNotFoundError(detail, options...)
+}
+
+// NewNugetDependenciesSpaceLimitExceededError calls dotnet.NewNugetDependenciesSpaceLimitExceededError, for existing callers of this package.
+func NewNugetDependenciesSpaceLimitExceededError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewNugetDependenciesSpaceLimitExceededError(detail, options...)
+}
+
+// NewRestoreFailedError calls dotnet.NewRestoreFailedError, for existing callers of this package.
+func NewRestoreFailedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewRestoreFailedError(detail, options...)
+}
+
+// NewCpmVersionOverrideError calls dotnet.NewCpmVersionOverrideError, for existing callers of this package.
+func NewCpmVersionOverrideError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewCpmVersionOverrideError(detail, options...)
+}
+
+// NewCpmMissingPackageVersionError calls dotnet.NewCpmMissingPackageVersionError, for existing callers of this package.
+func NewCpmMissingPackageVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewCpmMissingPackageVersionError(detail, options...)
+}
+
+// NewCpmDisabledOrMissingVersionError calls dotnet.NewCpmDisabledOrMissingVersionError, for existing callers of this package.
+func NewCpmDisabledOrMissingVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewCpmDisabledOrMissingVersionError(detail, options...)
+}
+
+// NewIncompatibleTargetFrameworkError calls dotnet.NewIncompatibleTargetFrameworkError, for existing callers of this package.
+func NewIncompatibleTargetFrameworkError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return dotnet.NewIncompatibleTargetFrameworkError(detail, options...)
+}
+
+// NewPrivateModuleError calls golang.NewPrivateModuleError, for existing callers of this package.
+func NewPrivateModuleError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewPrivateModuleError(detail, options...)
+}
+
+// NewGoModFileMissingError calls golang.NewGoModFileMissingError, for existing callers of this package.
+func NewGoModFileMissingError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGoModFileMissingError(detail, options...)
+}
+
+// NewSsoReAuthRequiredError calls golang.NewSsoReAuthRequiredError, for existing callers of this package.
+//
+// Deprecated: This error has been moved to a more generalized namespace to avoid repetition.  Use SNYK-OS-8004 instead.
+func NewSsoReAuthRequiredError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewSsoReAuthRequiredError(detail, options...)
+}
+
+// NewIncompleteProjectError calls golang.NewIncompleteProjectError, for existing callers of this package.
+func NewIncompleteProjectError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewIncompleteProjectError(detail, options...)
+}
+
+// NewInconsistentVendoringError calls golang.NewInconsistentVendoringError, for existing callers of this package.
+func NewInconsistentVendoringError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewInconsistentVendoringError(detail, options...)
+}
+
+// NewUnsupportedExternalFileGenerationSCMError calls golang.NewUnsupportedExternalFileGenerationSCMError, for existing callers of this package.
+func NewUnsupportedExternalFileGenerationSCMError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewUnsupportedExternalFileGenerationSCMError(detail, options...)
+}
+
+// NewUnableToAccessPrivateDepsError calls golang.NewUnableToAccessPrivateDepsError, for existing callers of this package.
+func NewUnableToAccessPrivateDepsError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewUnableToAccessPrivateDepsError(detail, options...)
+}
+
+// NewUnableToUseCredentialsError calls golang.NewUnableToUseCredentialsError, for existing callers of this package.
+func NewUnableToUseCredentialsError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewUnableToUseCredentialsError(detail, options...)
+}
+
+// NewToolchainNotAvailableError calls golang.NewToolchainNotAvailableError, for existing callers of this package.
+func NewToolchainNotAvailableError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewToolchainNotAvailableError(detail, options...)
+}
+
+// NewGolangSpaceLimitExceededError calls golang.NewGolangSpaceLimitExceededError, for existing callers of this package.
+func NewGolangSpaceLimitExceededError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangSpaceLimitExceededError(detail, options...)
+}
+
+// NewGolangNoSecureProtocolFoundError calls golang.NewGolangNoSecureProtocolFoundError, for existing callers of this package.
+func NewGolangNoSecureProtocolFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangNoSecureProtocolFoundError(detail, options...)
+}
+
+// NewGolangConnectionResetByPeerError calls golang.NewGolangConnectionResetByPeerError, for existing callers of this package.
+func NewGolangConnectionResetByPeerError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangConnectionResetByPeerError(detail, options...)
+}
+
+// NewGolangInvalidZipFileError calls golang.NewGolangInvalidZipFileError, for existing callers of this package.
+func NewGolangInvalidZipFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangInvalidZipFileError(detail, options...)
+}
+
+// NewGolangVersionMismatchError calls golang.NewGolangVersionMismatchError, for existing callers of this package.
+func NewGolangVersionMismatchError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangVersionMismatchError(detail, options...)
+}
+
+// NewGolangInvalidGoVersionError calls golang.NewGolangInvalidGoVersionError, for existing callers of this package.
+func NewGolangInvalidGoVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangInvalidGoVersionError(detail, options...)
+}
+
+// NewGolangDialTcpTimeoutError calls golang.NewGolangDialTcpTimeoutError, for existing callers of this package.
+func NewGolangDialTcpTimeoutError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangDialTcpTimeoutError(detail, options...)
+}
+
+// NewGolangHostKeyVerificationFailedError calls golang.NewGolangHostKeyVerificationFailedError, for existing callers of this package.
+func NewGolangHostKeyVerificationFailedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangHostKeyVerificationFailedError(detail, options...)
+}
+
+// NewGolangMissingModuleDeclarationError calls golang.NewGolangMissingModuleDeclarationError, for existing callers of this package.
+func NewGolangMissingModuleDeclarationError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangMissingModuleDeclarationError(detail, options...)
+}
+
+// NewGolangModuleVersionConstraintNotMetError calls golang.NewGolangModuleVersionConstraintNotMetError, for existing callers of this package.
+func NewGolangModuleVersionConstraintNotMetError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return golang.NewGolangModuleVersionConstraintNotMetError(detail, options...)
+}
+
+// NewMissingRequirementFromPomError calls maven.NewMissingRequirementFromPomError, for existing callers of this package.
+func NewMissingRequirementFromPomError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewMissingRequirementFromPomError(detail, options...)
+}
+
+// NewUnableToResolveValueForPropertyError calls maven.NewUnableToResolveValueForPropertyError, for existing callers of this package.
+func NewUnableToResolveValueForPropertyError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewUnableToResolveValueForPropertyError(detail, options...)
+}
+
+// NewUnableToResolveVersionForPropertyError calls maven.NewUnableToResolveVersionForPropertyError, for existing callers of this package.
+func NewUnableToResolveVersionForPropertyError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewUnableToResolveVersionForPropertyError(detail, options...)
+}
+
+// NewCyclicPropertyDetectedInPomFileError calls maven.NewCyclicPropertyDetectedInPomFileError, for existing callers of this package.
+func NewCyclicPropertyDetectedInPomFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewCyclicPropertyDetectedInPomFileError(detail, options...)
+}
+
+// NewUnableToParseXMLError calls maven.NewUnableToParseXMLError, for existing callers of this package.
+func NewUnableToParseXMLError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewUnableToParseXMLError(detail, options...)
+}
+
+// NewInvalidCoordinatesError calls maven.NewInvalidCoordinatesError, for existing callers of this package.
+func NewInvalidCoordinatesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewInvalidCoordinatesError(detail, options...)
+}
+
+// NewSkippedGroupError calls maven.NewSkippedGroupError, for existing callers of this package.
+func NewSkippedGroupError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewSkippedGroupError(detail, options...)
+}
+
+// NewPomFileNotFoundError calls maven.NewPomFileNotFoundError, for existing callers of this package.
+func NewPomFileNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewPomFileNotFoundError(detail, options...)
+}
+
+// NewMissingProjectFromPomError calls maven.NewMissingProjectFromPomError, for existing callers of this package.
+func NewMissingProjectFromPomError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewMissingProjectFromPomError(detail, options...)
+}
+
+// NewCannotResolveTargetPomFromXmlError calls maven.NewCannotResolveTargetPomFromXmlError, for existing callers of this package.
+func NewCannotResolveTargetPomFromXmlError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewCannotResolveTargetPomFromXmlError(detail, options...)
+}
+
+// NewCannotResolveTargetPomFromRepoError calls maven.NewCannotResolveTargetPomFromRepoError, for existing callers of this package.
+func NewCannotResolveTargetPomFromRepoError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewCannotResolveTargetPomFromRepoError(detail, options...)
+}
+
+// NewCannotGetBuildFileFromRepoError calls maven.NewCannotGetBuildFileFromRepoError, for existing callers of this package.
+func NewCannotGetBuildFileFromRepoError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewCannotGetBuildFileFromRepoError(detail, options...)
+}
+
+// NewCannotCreateGitHostError calls maven.NewCannotCreateGitHostError, for existing callers of this package.
+func NewCannotCreateGitHostError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewCannotCreateGitHostError(detail, options...)
+}
+
+// NewNoReleasedVersionForVersionsRangeError calls maven.NewNoReleasedVersionForVersionsRangeError, for existing callers of this package.
+func NewNoReleasedVersionForVersionsRangeError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewNoReleasedVersionForVersionsRangeError(detail, options...)
+}
+
+// NewSourceNotSupportedError calls maven.NewSourceNotSupportedError, for existing callers of this package.
+func NewSourceNotSupportedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewSourceNotSupportedError(detail, options...)
+}
+
+// NewTimeoutWhenProcessingTheDepTreeError calls maven.NewTimeoutWhenProcessingTheDepTreeError, for existing callers of this package.
+func NewTimeoutWhenProcessingTheDepTreeError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewTimeoutWhenProcessingTheDepTreeError(detail, options...)
+}
+
+// NewCannotReachConfiguredRepositoryError calls maven.NewCannotReachConfiguredRepositoryError, for existing callers of this package.
+func NewCannotReachConfiguredRepositoryError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewCannotReachConfiguredRepositoryError(detail, options...)
+}
+
+// NewFailedToBuildMavenProjectError calls maven.NewFailedToBuildMavenProjectError, for existing callers of this package.
+func NewFailedToBuildMavenProjectError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return maven.NewFailedToBuildMavenProjectError(detail, options...)
+}
+
+// NewNoRepoFoundForTheNPMPackageError calls nodejs.NewNoRepoFoundForTheNPMPackageError, for existing callers of this package.
+func NewNoRepoFoundForTheNPMPackageError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewNoRepoFoundForTheNPMPackageError(detail, options...)
+}
+
+// NewCouldNotParseNPMRegistryURLError calls nodejs.NewCouldNotParseNPMRegistryURLError, for existing callers of this package.
+func NewCouldNotParseNPMRegistryURLError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewCouldNotParseNPMRegistryURLError(detail, options...)
+}
+
+// NewCouldNotFindBrokerURLError calls nodejs.NewCouldNotFindBrokerURLError, for existing callers of this package.
+func NewCouldNotFindBrokerURLError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewCouldNotFindBrokerURLError(detail, options...)
+}
+
+// NewUnableToReplaceBrokerURLError calls nodejs.NewUnableToReplaceBrokerURLError, for existing callers of this package.
+func NewUnableToReplaceBrokerURLError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewUnableToReplaceBrokerURLError(detail, options...)
+}
+
+// NewBadNPMVersionError calls nodejs.NewBadNPMVersionError, for existing callers of this package.
+func NewBadNPMVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewBadNPMVersionError(detail, options...)
+}
+
+// NewUnknownBlobEncodingOnGithubError calls nodejs.NewUnknownBlobEncodingOnGithubError, for existing callers of this package.
+func NewUnknownBlobEncodingOnGithubError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewUnknownBlobEncodingOnGithubError(detail, options...)
+}
+
+// NewNoResultsFromForkerProcessesError calls nodejs.NewNoResultsFromForkerProcessesError, for existing callers of this package.
+func NewNoResultsFromForkerProcessesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewNoResultsFromForkerProcessesError(detail, options...)
+}
+
+// NewChildProcessExecutionError calls nodejs.NewChildProcessExecutionError, for existing callers of this package.
+func NewChildProcessExecutionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewChildProcessExecutionError(detail, options...)
+}
+
+// NewNoValidPackageUpgradesError calls nodejs.NewNoValidPackageUpgradesError, for existing callers of this package.
+func NewNoValidPackageUpgradesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewNoValidPackageUpgradesError(detail, options...)
+}
+
+// NewNoDependencyUpdatesError calls nodejs.NewNoDependencyUpdatesError, for existing callers of this package.
+func NewNoDependencyUpdatesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewNoDependencyUpdatesError(detail, options...)
+}
+
+// NewCouldNotParseJSONFileError calls nodejs.NewCouldNotParseJSONFileError, for existing callers of this package.
+func NewCouldNotParseJSONFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewCouldNotParseJSONFileError(detail, options...)
+}
+
+// NewBase64EncodeError calls nodejs.NewBase64EncodeError, for existing callers of this package.
+func NewBase64EncodeError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewBase64EncodeError(detail, options...)
+}
+
+// NewBase64DecodeError calls nodejs.NewBase64DecodeError, for existing callers of this package.
+func NewBase64DecodeError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewBase64DecodeError(detail, options...)
+}
+
+// NewMissingSupportedFileError calls nodejs.NewMissingSupportedFileError, for existing callers of this package.
+func NewMissingSupportedFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewMissingSupportedFileError(detail, options...)
+}
+
+// NewInvalidConfigurationError calls nodejs.NewInvalidConfigurationError, for existing callers of this package.
+func NewInvalidConfigurationError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewInvalidConfigurationError(detail, options...)
+}
+
+// NewPnpmOutOfSyncError calls nodejs.NewPnpmOutOfSyncError, for existing callers of this package.
+func NewPnpmOutOfSyncError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewPnpmOutOfSyncError(detail, options...)
+}
+
+// NewPnpmUnsupportedLockfileVersionError calls nodejs.NewPnpmUnsupportedLockfileVersionError, for existing callers of this package.
+func NewPnpmUnsupportedLockfileVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewPnpmUnsupportedLockfileVersionError(detail, options...)
+}
+
+// NewYarnPackageNotFoundError calls nodejs.NewYarnPackageNotFoundError, for existing callers of this package.
+func NewYarnPackageNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewYarnPackageNotFoundError(detail, options...)
+}
+
+// NewUnableToReachRegistryError calls nodejs.NewUnableToReachRegistryError, for existing callers of this package.
+func NewUnableToReachRegistryError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewUnableToReachRegistryError(detail, options...)
+}
+
+// NewOutdatedYarnLockFileError calls nodejs.NewOutdatedYarnLockFileError, for existing callers of this package.
+func NewOutdatedYarnLockFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewOutdatedYarnLockFileError(detail, options...)
+}
+
+// NewPermissionDeniedError calls nodejs.NewPermissionDeniedError, for existing callers of this package.
+func NewPermissionDeniedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return nodejs.NewPermissionDeniedError(detail, options...)
+}
+
+// NewUnsupportedRequirementsFileError calls python.NewUnsupportedRequirementsFileError, for existing callers of this package.
+func NewUnsupportedRequirementsFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewUnsupportedRequirementsFileError(detail, options...)
+}
+
+// NewTooManyManifestFilesError calls python.NewTooManyManifestFilesError, for existing callers of this package.
+func NewTooManyManifestFilesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewTooManyManifestFilesError(detail, options...)
+}
+
+// NewFailedToApplyDependencyUpdatesError calls python.NewFailedToApplyDependencyUpdatesError, for existing callers of this package.
+func NewFailedToApplyDependencyUpdatesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewFailedToApplyDependencyUpdatesError(detail, options...)
+}
+
+// NewPythonPackageNotFoundError calls python.NewPythonPackageNotFoundError, for existing callers of this package.
+func NewPythonPackageNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPythonPackageNotFoundError(detail, options...)
+}
+
+// NewSyntaxIssuesError calls python.NewSyntaxIssuesError, for existing callers of this package.
+func NewSyntaxIssuesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewSyntaxIssuesError(detail, options...)
+}
+
+// NewPipUnsupportedPythonVersionError calls python.NewPipUnsupportedPythonVersionError, for existing callers of this package.
+func NewPipUnsupportedPythonVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPipUnsupportedPythonVersionError(detail, options...)
+}
+
+// NewPythonVersionConfictError calls python.NewPythonVersionConfictError, for existing callers of this package.
+func NewPythonVersionConfictError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPythonVersionConfictError(detail, options...)
+}
+
+// NewPipNoMatchingPythonDistributionError calls python.NewPipNoMatchingPythonDistributionError, for existing callers of this package.
+func NewPipNoMatchingPythonDistributionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPipNoMatchingPythonDistributionError(detail, options...)
+}
+
+// NewInstallationFailureError calls python.NewInstallationFailureError, for existing callers of this package.
+func NewInstallationFailureError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewInstallationFailureError(detail, options...)
+}
+
+// NewPipenvUnsupportedPythonVersionError calls python.NewPipenvUnsupportedPythonVersionError, for existing callers of this package.
+func NewPipenvUnsupportedPythonVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPipenvUnsupportedPythonVersionError(detail, options...)
+}
+
+// NewPipenvNoMatchingPythonDistributionError calls python.NewPipenvNoMatchingPythonDistributionError, for existing callers of this package.
+func NewPipenvNoMatchingPythonDistributionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPipenvNoMatchingPythonDistributionError(detail, options...)
+}
+
+// NewPythonDependenciesSpaceLimitExceededError calls python.NewPythonDependenciesSpaceLimitExceededError, for existing callers of this package.
+func NewPythonDependenciesSpaceLimitExceededError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPythonDependenciesSpaceLimitExceededError(detail, options...)
+}
+
+// NewPythonRequiredPackagesMissingError calls python.NewPythonRequiredPackagesMissingError, for existing callers of this package.
+func NewPythonRequiredPackagesMissingError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPythonRequiredPackagesMissingError(detail, options...)
+}
+
+// NewPythonFailedToWriteTempFilesError calls python.NewPythonFailedToWriteTempFilesError, for existing callers of this package.
+func NewPythonFailedToWriteTempFilesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return python.NewPythonFailedToWriteTempFilesError(detail, options...)
+}
+
+// NewCyclicDependencyDetectedError calls ruby.NewCyclicDependencyDetectedError, for existing callers of this package.
+func NewCyclicDependencyDetectedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return ruby.NewCyclicDependencyDetectedError(detail, options...)
+}
+
+// NewGemNotFoundError calls ruby.NewGemNotFoundError, for existing callers of this package.
+func NewGemNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return ruby.NewGemNotFoundError(detail, options...)
+}
+
+// NewGemVersionConflictError calls ruby.NewGemVersionConflictError, for existing callers of this package.
+func NewGemVersionConflictError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return ruby.NewGemVersionConflictError(detail, options...)
+}
+
+// NewReachabilitySettingDisabledError calls settings.NewReachabilitySettingDisabledError, for existing callers of this package.
+func NewReachabilitySettingDisabledError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return settings.NewReachabilitySettingDisabledError(detail, options...)
+}
+
+// NewUvNoProjectRootError calls uv.NewUvNoProjectRootError, for existing callers of this package.
+func NewUvNoProjectRootError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+	return uv.NewUvNoProjectRootError(detail, options...)
+}
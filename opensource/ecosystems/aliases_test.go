@@ -0,0 +1,55 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ecosystems_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/golang"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/maven"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestAliases(t *testing.T) {
+	type test struct {
+		description string
+		alias       func(string, ...snyk_errors.Option) snyk_errors.Error
+		group       func(string, ...snyk_errors.Option) snyk_errors.Error
+		code        string
+	}
+
+	tests := []test{
+		{description: "go", alias: ecosystems.NewSsoReAuthRequiredError, group: golang.NewSsoReAuthRequiredError, code: errorcodes.OpenSourceEcosystems.Go.SsoReAuthRequiredError},
+		{description: "maven", alias: ecosystems.NewPomFileNotFoundError, group: maven.NewPomFileNotFoundError, code: errorcodes.OpenSourceEcosystems.Maven.PomFileNotFoundError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			cause := snyk_errors.WithCause(snyk_errors.Error{Title: "cause"})
+
+			actual := tt.alias("detail", cause)
+			expected := tt.group("detail", cause)
+			expected.ID = actual.ID
+
+			require.Equal(t, expected, actual)
+			require.Equal(t, tt.code, actual.ErrorCode)
+		})
+	}
+}
@@ -0,0 +1,627 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package dotnet contains errors related to the group DotNet of the namespace
+// OpenSourceEcosystems of the Error Catalog.
+package dotnet
+
+import (
+  "github.com/snyk/error-catalog-golang-public/snyk_errors"
+  "github.com/google/uuid"
+)
+// NewUnsupportedManifestFileError displays errors with the following description:
+// The provided manifest file is not supported by Snyk for .NET.
+//
+// Read more:
+// - https://docs.snyk.io/scan-applications/supported-languages-and-frameworks/.net
+func NewUnsupportedManifestFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0001",
+    Title:      "Unsupported manifest file type for remediation",
+    Description: "The provided manifest file is not supported by Snyk for .NET.",
+    StatusCode: 400,
+    ErrorCode:  "SNYK-OS-DOTNET-0001",
+    Classification: "UNSUPPORTED",
+    Links: []string{
+      "https://docs.snyk.io/scan-applications/supported-languages-and-frameworks/.net",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnsupportedTargetFrameworkError displays errors with the following description:
+// The provided manifest file defines a `<TargetFramework>` or `<TargetFrameworks>` that is not currently supported by Snyk's .NET scanning solution.
+func NewUnsupportedTargetFrameworkError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0002",
+    Title:      "Target framework not supported",
+    Description: "The provided manifest file defines a `<TargetFramework>` or `<TargetFrameworks>` that is not currently supported by Snyk's .NET scanning solution.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0002",
+    Classification: "UNSUPPORTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewMissingStaticMainFunctionError displays errors with the following description:
+// This error occurs when no static Main method with a correct signature is found in the code that produces an executable file. 
+// It also occurs if the entry point function, `Main`, is defined with the wrong case, such as lower-case main.
+// 
+// In order to fix this issue, ensure that your program has a .cs file that contains a main function, such as
+// ```c#
+// namespace Example
+// {
+//     class Program
+//     {
+//         static void Main(string[] args)
+//         {
+//             Console.WriteLine("hello world");
+//         }
+//     }
+// }
+// ```
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/dotnet/csharp/misc/cs5001
+func NewMissingStaticMainFunctionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0003",
+    Title:      "Your C# code is missing a static Main function",
+    Description: "This error occurs when no static Main method with a correct signature is found in the code that produces an executable file. \nIt also occurs if the entry point function, `Main`, is defined with the wrong case, such as lower-case main.\n\nIn order to fix this issue, ensure that your program has a .cs file that contains a main function, such as\n```c#\nnamespace Example\n{\n    class Program\n    {\n        static void Main(string[] args)\n        {\n            Console.WriteLine(\"hello world\");\n        }\n    }\n}\n```",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0003",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/dotnet/csharp/misc/cs5001",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPublishFailedError displays errors with the following description:
+// This error occurs when running `dotnet publish --sc --framework <your-target-framework>` fails to generate a 
+// self-contained binary. Snyk needs to run this command in order to adequately determine the dependency tree for your project. If this command fails, Snyk cannot continue.
+// 
+// Steps to determine why this happened:
+// 
+// * Checkout a clean version of your project in a temporary folder
+// * Run `dotnet publish --sc --framework <your-target-framework> ` on your project, and confirm this step fails.
+// 
+// If this step is successful locally, it is possible that Snyk is running another version of the .NET SDK. To tell Snyk which version of the .NET SDK to use, consider using the [global.json](https://learn.microsoft.com/en-us/dotnet/core/tools/global-json) solution provided by Microsoft.
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/dotnet/core/tools/sdk-errors/
+// - https://learn.microsoft.com/en-us/dotnet/core/tools/global-json
+// - https://github.com/snyk/snyk-nuget-plugin/blob/885486aa656c28d3db465c8d22710770d5cc6773/lib/nuget-parser/cli/dotnet.ts#L67
+func NewPublishFailedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0004",
+    Title:      "The dotnet CLI is unable to generate a self-contained binary",
+    Description: "This error occurs when running `dotnet publish --sc --framework <your-target-framework>` fails to generate a \nself-contained binary. Snyk needs to run this command in order to adequately determine the dependency tree for your project. If this command fails, Snyk cannot continue.\n\nSteps to determine why this happened:\n\n* Checkout a clean version of your project in a temporary folder\n* Run `dotnet publish --sc --framework <your-target-framework> ` on your project, and confirm this step fails.\n\nIf this step is successful locally, it is possible that Snyk is running another version of the .NET SDK. To tell Snyk which version of the .NET SDK to use, consider using the [global.json](https://learn.microsoft.com/en-us/dotnet/core/tools/global-json) solution provided by Microsoft.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0004",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/dotnet/core/tools/sdk-errors/",
+      "https://learn.microsoft.com/en-us/dotnet/core/tools/global-json",
+      "https://github.com/snyk/snyk-nuget-plugin/blob/885486aa656c28d3db465c8d22710770d5cc6773/lib/nuget-parser/cli/dotnet.ts#L67",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewFailedToAccessPrivatePackageSourceError displays errors with the following description:
+// This error occurs when running `dotnet restore` fails to access dependencies stored in a private package source that Snyk does not have access to. 
+// 
+// This means that your `.csproj` file or files refer to a dependency hosted on a private package store or Nuget Artifact Registry defined in your `NuGet.config` file, such as:
+// 
+// ```xml
+// <?xml version="1.0" encoding="utf-8"?>
+// <configuration>
+//   <packageSources>
+//     <clear />
+//     <add key="AzureFeed" value="https://pkgs.dev.azure.com/your-org/_packaging/your-repo/nuget/v3/index.json" />
+//     <add key="nuget.org" value="https://api.nuget.org/v3/index.json" />
+//   </packageSources>
+// </configuration>
+// ```
+// 
+// In order to allow Snyk to access your private dependency package source, you must supply Snyk with a valid JSON object as a private registry token in the .NET language settings.
+// 
+// You can set up a connection to your private Nuget repository in your Snyk integration settings.
+//
+// Read more:
+// - https://github.com/microsoft/artifacts-credprovider#environment-variables
+func NewFailedToAccessPrivatePackageSourceError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0005",
+    Title:      "The dotnet CLI was unable to restore from private package sources",
+    Description: "This error occurs when running `dotnet restore` fails to access dependencies stored in a private package source that Snyk does not have access to. \n\nThis means that your `.csproj` file or files refer to a dependency hosted on a private package store or Nuget Artifact Registry defined in your `NuGet.config` file, such as:\n\n```xml\n<?xml version=\"1.0\" encoding=\"utf-8\"?>\n<configuration>\n  <packageSources>\n    <clear />\n    <add key=\"AzureFeed\" value=\"https://pkgs.dev.azure.com/your-org/_packaging/your-repo/nuget/v3/index.json\" />\n    <add key=\"nuget.org\" value=\"https://api.nuget.org/v3/index.json\" />\n  </packageSources>\n</configuration>\n```\n\nIn order to allow Snyk to access your private dependency package source, you must supply Snyk with a valid JSON object as a private registry token in the .NET language settings.\n\nYou can set up a connection to your private Nuget repository in your Snyk integration settings.",
+    StatusCode: 401,
+    ErrorCode:  "SNYK-OS-DOTNET-0005",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://github.com/microsoft/artifacts-credprovider#environment-variables",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewMissingMSBuildConditionError displays errors with the following description:
+// The `dotnet` tool was unable to locate the `.targets`, `.csproj` or `.props` file responsible for one or more MSBuild conditions in your project file.
+// 
+// The tool encountered an error like 
+// ```
+// /path/to/file/project.csproj(33,13): error MSB4100: Expected "$(SomeCondition)" to evaluate to a boolean instead of "", in condition "!$(SomeCondition)".
+// ```
+// 
+// This means the condition definition is missing in the project file that is currently being restored and in any project linked to it from there.      
+// 
+// Snyk can scan only the project files accessible in the current repository or the private dependencies available to Snyk.
+// 
+// For example, if your code has the following structure:
+// 
+// ```title=project.targets
+// <Project>
+//   <PropertyGroup>
+//     <SomeCondition Condition="'$(SomeCondition)' == ''">false</SomeCondition>
+//   </PropertyGroup>
+// </Project>
+// ```
+// 
+// And
+// 
+// ```title=project.csproj
+// <Project Sdk='Microsoft.NET.Sdk'>
+//   <Import Project='..\external-libraries\some-library\project.targets' />
+//   <PropertyGroup>
+//     <TargetFrameworks>net8.0</TargetFrameworks>
+//   </PropertyGroup>
+//   <ItemGroup Condition='!$(SomeCondition)'>
+//     <PackageReference Include='Newtonsoft.Json' Version='13.0.3' />
+//   </ItemGroup>
+// </Project>
+// ```
+// 
+// And `external-libraries` is not a part of your repository currently being scanned, Snyk is not able to find it.
+// 
+// This error occurs when your code depends on external libraries that are added to or generated from your source code using external tools unknown to Snyk or as part of a build step in your build or a deployment pipeline.
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/visualstudio/msbuild/msbuild-conditional-constructs
+func NewMissingMSBuildConditionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0006",
+    Title:      "Missing MSBuild Condition Construct in project file",
+    Description: "The `dotnet` tool was unable to locate the `.targets`, `.csproj` or `.props` file responsible for one or more MSBuild conditions in your project file.\n\nThe tool encountered an error like \n```\n/path/to/file/project.csproj(33,13): error MSB4100: Expected \"$(SomeCondition)\" to evaluate to a boolean instead of \"\", in condition \"!$(SomeCondition)\".\n```\n\nThis means the condition definition is missing in the project file that is currently being restored and in any project linked to it from there.      \n\nSnyk can scan only the project files accessible in the current repository or the private dependencies available to Snyk.\n\nFor example, if your code has the following structure:\n\n```title=project.targets\n<Project>\n  <PropertyGroup>\n    <SomeCondition Condition=\"'$(SomeCondition)' == ''\">false</SomeCondition>\n  </PropertyGroup>\n</Project>\n```\n\nAnd\n\n```title=project.csproj\n<Project Sdk='Microsoft.NET.Sdk'>\n  <Import Project='..\\external-libraries\\some-library\\project.targets' />\n  <PropertyGroup>\n    <TargetFrameworks>net8.0</TargetFrameworks>\n  </PropertyGroup>\n  <ItemGroup Condition='!$(SomeCondition)'>\n    <PackageReference Include='Newtonsoft.Json' Version='13.0.3' />\n  </ItemGroup>\n</Project>\n```\n\nAnd `external-libraries` is not a part of your repository currently being scanned, Snyk is not able to find it.\n\nThis error occurs when your code depends on external libraries that are added to or generated from your source code using external tools unknown to Snyk or as part of a build step in your build or a deployment pipeline.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0006",
+    Classification: "UNSUPPORTED",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/visualstudio/msbuild/msbuild-conditional-constructs",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewNoTargetFrameworksFoundError displays errors with the following description:
+// Snyk was unable to detect any `<TargetFramework>`s in the supplied manifest files. 
+// 
+// If you are using `Directory.Build.props` files to determine the target framework, ensure that it is named as such. Due to performance considerations on the customer's SCM network, Snyk does not perform case-insensitive searches for `.props` files.
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/visualstudio/msbuild/customize-by-directory?view=vs-2022#directorybuildprops-and-directorybuildtargets
+func NewNoTargetFrameworksFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0007",
+    Title:      "No target frameworks found in manifest files",
+    Description: "Snyk was unable to detect any `<TargetFramework>`s in the supplied manifest files. \n\nIf you are using `Directory.Build.props` files to determine the target framework, ensure that it is named as such. Due to performance considerations on the customer's SCM network, Snyk does not perform case-insensitive searches for `.props` files.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0007",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/visualstudio/msbuild/customize-by-directory?view=vs-2022#directorybuildprops-and-directorybuildtargets",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewOutdatedSDKVersionRequestedError displays errors with the following description:
+// Snyk supports the latest channels of .NET which is currently [supported by Microsoft](https://dotnet.microsoft.com/en-us/download/dotnet), but does **not** guarantee to support all SDK versions within each currently supported channel.
+// 
+// Within the supported channels, Snyk aims to support most, if not all, of the SDK versions currently released under the **newest** of the channels.
+// 
+// If the channels currently supported by Microsoft are `8.0`, `7.0` and `6.0`, Snyk **will** support all of the *latest* SDKs released for these channels.
+// 
+// If the SDK versions released under `8.0.3` are: `8.0.203`, `8.0.202` and `8.0.103`, Snyk **cannot** guarantee to support *all* of them, but makes an effort to do so. Snyk **will** support the latest of the SDK versions currently released by Microsoft. 
+// 
+// If channel `8.0` is the newest channel currently supported, Snyk **cannot** guarantee that multiple, specific SDK versions for older, still supported channels such as .NET 6. 
+// 
+// ### Example support matrix
+// 
+// If:
+// 
+// * .NET channels currently supported by Microsoft are `.NET 8.0`,  `.NET 7.0` and  `.NET 6.0`
+// * Newest SDK version under `.NET 8.0` is `8.0.203`
+// 
+// Then:
+// 
+// | Channel |              SDK             | End-of-Life |  Supported  |
+// |:-------:|:----------------------------:|:-----------:|:-----------:|
+// |   8.0   | 8.0.203  (latest in channel) |      No     |     Yes     |
+// |   8.0   |            8.0.202           |      No     |     Yes     |
+// |   8.0   |            8.0.103           |      No     |     Yes     |
+// |         |             (...)            |             |             |
+// |   7.0   | 7.0.407  (latest in channel) |      No     |     Yes     |
+// |   7.0   |            7.0.314           |      No     |      No     |
+// |         |             (...)            |             |             |
+// |   6.0   |            6.0.420           |      No     |     Yes     |
+// |   6.0   |            6.0.128           |      No     |      No     |
+// |         |             (..)             |             |             |
+// |   5.0   |  5.0.408 (latest in channel) |     Yes     |      No     |
+// |   5.0   |            5.0.214           |     Yes     |      No     |
+// |         |             (..)             |             |             |
+// 
+// ### Workarounds
+// 
+// This limitation can lead to scan failures for customers that are pinning SDK versions in their `global.json` files without a [rollForward](https://learn.microsoft.com/en-us/dotnet/core/tools/global-json#rollforward) directive, such as:
+// ```json
+// {
+//   "sdk": {
+//     "version": "6.0.101"
+//   }
+// }
+// ```
+// Since as `6.0` is not the newest .NET channel. 
+// 
+// To work around this issue, we recommend that customers employ some flexibility in their `global.json` file by employing the `rollFoward` directive to be `latestMajor`, as such:
+// ```json
+// {
+//   "sdk": {
+//     "version": "6.0.101",
+//     "rollForward": "latestMajor"
+//   }
+// }
+// ```
+// 
+// Which will allow Snyk to scan your code using a newer version of the SDK, despite your version pinning.
+//
+// Read more:
+// - https://versionsof.net/core/
+// - https://dotnet.microsoft.com/en-us/download/dotnet
+// - https://learn.microsoft.com/en-us/dotnet/core/tools/global-json#rollforward
+func NewOutdatedSDKVersionRequestedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0008",
+    Title:      "Your global.json is targeting an outdated SDK version",
+    Description: "Snyk supports the latest channels of .NET which is currently [supported by Microsoft](https://dotnet.microsoft.com/en-us/download/dotnet), but does **not** guarantee to support all SDK versions within each currently supported channel.\n\nWithin the supported channels, Snyk aims to support most, if not all, of the SDK versions currently released under the **newest** of the channels.\n\nIf the channels currently supported by Microsoft are `8.0`, `7.0` and `6.0`, Snyk **will** support all of the *latest* SDKs released for these channels.\n\nIf the SDK versions released under `8.0.3` are: `8.0.203`, `8.0.202` and `8.0.103`, Snyk **cannot** guarantee to support *all* of them, but makes an effort to do so. Snyk **will** support the latest of the SDK versions currently released by Microsoft. \n\nIf channel `8.0` is the newest channel currently supported, Snyk **cannot** guarantee that multiple, specific SDK versions for older, still supported channels such as .NET 6. \n\n### Example support matrix\n\nIf:\n\n* .NET channels currently supported by Microsoft are `.NET 8.0`,  `.NET 7.0` and  `.NET 6.0`\n* Newest SDK version under `.NET 8.0` is `8.0.203`\n\nThen:\n\n| Channel |              SDK             | End-of-Life |  Supported  |\n|:-------:|:----------------------------:|:-----------:|:-----------:|\n|   8.0   | 8.0.203  (latest in channel) |      No     |     Yes     |\n|   8.0   |            8.0.202           |      No     |     Yes     |\n|   8.0   |            8.0.103           |      No     |     Yes     |\n|         |             (...)            |             |             |\n|   7.0   | 7.0.407  (latest in channel) |      No     |     Yes     |\n|   7.0   |            7.0.314           |      No     |      No     |\n|         |             (...)            |             |             |\n|   6.0   |            6.0.420           |      No     |     Yes     |\n|   6.0   |            6.0.128           |      No     |      No     |\n|         |             (..)             |             |             |\n|   5.0   |  5.0.408 (latest in channel) |     Yes     |      No     |\n|   5.0   |            5.0.214           |     Yes     |      No     |\n|         |             (..)             |             |             |\n\n### Workarounds\n\nThis limitation can lead to scan failures for customers that are pinning SDK versions in their `global.json` files without a [rollForward](https://learn.microsoft.com/en-us/dotnet/core/tools/global-json#rollforward) directive, such as:\n```json\n{\n  \"sdk\": {\n    \"version\": \"6.0.101\"\n  }\n}\n```\nSince as `6.0` is not the newest .NET channel. \n\nTo work around this issue, we recommend that customers employ some flexibility in their `global.json` file by employing the `rollFoward` directive to be `latestMajor`, as such:\n```json\n{\n  \"sdk\": {\n    \"version\": \"6.0.101\",\n    \"rollForward\": \"latestMajor\"\n  }\n}\n```\n\nWhich will allow Snyk to scan your code using a newer version of the SDK, despite your version pinning.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0008",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://versionsof.net/core/",
+      "https://dotnet.microsoft.com/en-us/download/dotnet",
+      "https://learn.microsoft.com/en-us/dotnet/core/tools/global-json#rollforward",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewProjectSkippedAndNotFoundError displays errors with the following description:
+// While attempting to build your solution for scanning, the `dotnet` SDK was unable to restore one or more projects referenced in your manifest files.
+// 
+// Please note that Snyk runs these builds on a **case-sensitive** filesystem, meaning that `<ProjectReference>../src/NS.Project.csproj</ProjectReference>` and `<ProjectReference>../src/ns.project.csproj</ProjectReference>` are not referring to the same thing.
+// 
+// This can present itself as a problem for customers that are using Mac or Windows build pipeline where file systems are not case-sensitive. In this case, verify you're referring to the right manifest files and check the Snyk import logs for more details.
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/dotnet/csharp/language-reference/compiler-messages/assembly-references#missing-references
+func NewProjectSkippedAndNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0009",
+    Title:      "Project failed to build due to missing type or namespace references",
+    Description: "While attempting to build your solution for scanning, the `dotnet` SDK was unable to restore one or more projects referenced in your manifest files.\n\nPlease note that Snyk runs these builds on a **case-sensitive** filesystem, meaning that `<ProjectReference>../src/NS.Project.csproj</ProjectReference>` and `<ProjectReference>../src/ns.project.csproj</ProjectReference>` are not referring to the same thing.\n\nThis can present itself as a problem for customers that are using Mac or Windows build pipeline where file systems are not case-sensitive. In this case, verify you're referring to the right manifest files and check the Snyk import logs for more details.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0009",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/dotnet/csharp/language-reference/compiler-messages/assembly-references#missing-references",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewNugetDependenciesSpaceLimitExceededError displays errors with the following description:
+// The total size of the downloaded Nuget dependencies in the manifest files exceeds the 10GB limit.
+// This often happens due to the large number or the large size of Nuget dependencies. 
+//
+// Read more:
+// - https://docs.snyk.io/supported-languages-package-managers-and-frameworks/.net/improved-.net-scanning#limitations-on-improved-.net-scanning-for-scm-integrations
+func NewNugetDependenciesSpaceLimitExceededError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0010",
+    Title:      "The 10 GB space limit for downloaded Nuget dependencies has been exceeded",
+    Description: "The total size of the downloaded Nuget dependencies in the manifest files exceeds the 10GB limit.\nThis often happens due to the large number or the large size of Nuget dependencies. ",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0010",
+    Classification: "UNSUPPORTED",
+    Links: []string{
+      "https://docs.snyk.io/supported-languages-package-managers-and-frameworks/.net/improved-.net-scanning#limitations-on-improved-.net-scanning-for-scm-integrations",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewRestoreFailedError displays errors with the following description:
+// This error occurs when running `dotnet restore <path-to-csproj>` fails to generate a 
+// manifest of the resolved dependencies. Snyk needs to run this command in order to adequately determine the dependency tree for your project. 
+// If this command fails, Snyk cannot continue.
+// 
+// Steps to determine why this happened:
+// 
+// * Checkout a clean version of your project in a temporary folder
+// * Run `dotnet restore <path-to-csproj> ` on your project, and confirm this step fails.
+// 
+// If this step is successful locally, it is possible that Snyk is running another version of the .NET SDK. To tell Snyk which version of the .NET SDK to use, consider using the [global.json](https://learn.microsoft.com/en-us/dotnet/core/tools/global-json) solution provided by Microsoft.
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/dotnet/core/tools/sdk-errors/
+// - https://learn.microsoft.com/en-us/dotnet/core/tools/global-json
+// - https://github.com/snyk/snyk-nuget-plugin/blob/885486aa656c28d3db465c8d22710770d5cc6773/lib/nuget-parser/cli/dotnet.ts#L50
+func NewRestoreFailedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0011",
+    Title:      "The dotnet CLI is unable to download and install all the required dependencies",
+    Description: "This error occurs when running `dotnet restore <path-to-csproj>` fails to generate a \nmanifest of the resolved dependencies. Snyk needs to run this command in order to adequately determine the dependency tree for your project. \nIf this command fails, Snyk cannot continue.\n\nSteps to determine why this happened:\n\n* Checkout a clean version of your project in a temporary folder\n* Run `dotnet restore <path-to-csproj> ` on your project, and confirm this step fails.\n\nIf this step is successful locally, it is possible that Snyk is running another version of the .NET SDK. To tell Snyk which version of the .NET SDK to use, consider using the [global.json](https://learn.microsoft.com/en-us/dotnet/core/tools/global-json) solution provided by Microsoft.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0011",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/dotnet/core/tools/sdk-errors/",
+      "https://learn.microsoft.com/en-us/dotnet/core/tools/global-json",
+      "https://github.com/snyk/snyk-nuget-plugin/blob/885486aa656c28d3db465c8d22710770d5cc6773/lib/nuget-parser/cli/dotnet.ts#L50",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCpmVersionOverrideError displays errors with the following description:
+// Your `dotnet restore` command failed with NuGet error **NU1008**.
+// 
+// This occurs because a `PackageReference` in your project file directly defines a package version when Central Package Management (CPM) is enabled. To resolve this, remove the `Version` attribute from the `PackageReference`. Define the package version instead as a `PackageVersion` entry in your `Directory.Packages.props` file. For project-specific exceptions, use `VersionOverride`.
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/nuget/reference/errors-and-warnings/nu1008
+// - https://learn.microsoft.com/en-us/nuget/consume-packages/central-package-management
+func NewCpmVersionOverrideError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0012",
+    Title:      "Package version defined incorrectly",
+    Description: "Your `dotnet restore` command failed with NuGet error **NU1008**.\n\nThis occurs because a `PackageReference` in your project file directly defines a package version when Central Package Management (CPM) is enabled. To resolve this, remove the `Version` attribute from the `PackageReference`. Define the package version instead as a `PackageVersion` entry in your `Directory.Packages.props` file. For project-specific exceptions, use `VersionOverride`.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0012",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/nuget/reference/errors-and-warnings/nu1008",
+      "https://learn.microsoft.com/en-us/nuget/consume-packages/central-package-management",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCpmMissingPackageVersionError displays errors with the following description:
+// The `dotnet restore` command failed with NuGet error NU1010.
+// 
+// Central Package Management (CPM) cannot find a matching `PackageVersion` for a `PackageReference` in your `Directory.Packages.props` file. To resolve this, add the necessary `PackageVersion` entry for the package to `Directory.Packages.props`. For example:
+// 
+// ```xml
+// <PackageVersion Include="Example.Package" Version="1.0.0" />
+// ```
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/nuget/reference/errors-and-warnings/nu1010
+// - https://learn.microsoft.com/en-us/nuget/consume-packages/central-package-management
+func NewCpmMissingPackageVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0013",
+    Title:      "Missing package version in CPM",
+    Description: "The `dotnet restore` command failed with NuGet error NU1010.\n\nCentral Package Management (CPM) cannot find a matching `PackageVersion` for a `PackageReference` in your `Directory.Packages.props` file. To resolve this, add the necessary `PackageVersion` entry for the package to `Directory.Packages.props`. For example:\n\n```xml\n<PackageVersion Include=\"Example.Package\" Version=\"1.0.0\" />\n```",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0013",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/nuget/reference/errors-and-warnings/nu1010",
+      "https://learn.microsoft.com/en-us/nuget/consume-packages/central-package-management",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCpmDisabledOrMissingVersionError displays errors with the following description:
+// The `dotnet restore` command failed with NuGet error NU1015.
+// 
+// This occurs because a `PackageReference` lacks a version and Central Package Management (CPM) is not active.
+// 
+// To resolve this, perform one of the following actions:
+// 
+// 1. Enable Central Package Management: In `Directory.Packages.props`, set `ManagePackageVersionsCentrally` to `true`. Ensure your project can access the file.
+// 2. Add an explicit `Version` attribute to the `PackageReference` in your project file.
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/nuget/reference/errors-and-warnings/nu1015
+// - https://learn.microsoft.com/en-us/nuget/consume-packages/central-package-management
+func NewCpmDisabledOrMissingVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0014",
+    Title:      "Missing package version in CPM and CPM not active",
+    Description: "The `dotnet restore` command failed with NuGet error NU1015.\n\nThis occurs because a `PackageReference` lacks a version and Central Package Management (CPM) is not active.\n\nTo resolve this, perform one of the following actions:\n\n1. Enable Central Package Management: In `Directory.Packages.props`, set `ManagePackageVersionsCentrally` to `true`. Ensure your project can access the file.\n2. Add an explicit `Version` attribute to the `PackageReference` in your project file.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0014",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/nuget/reference/errors-and-warnings/nu1015",
+      "https://learn.microsoft.com/en-us/nuget/consume-packages/central-package-management",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewIncompatibleTargetFrameworkError displays errors with the following description:
+// Your project failed to restore dependencies with NuGet error NU1202 because a dependency does not support the current `TargetFramework`. This means the dependency lacks assets for your project's framework.
+// 
+// To resolve this, perform one of the following actions:
+// 
+// 1. Change your project's `TargetFramework` to one the dependency supports.
+// 2. Update the dependency to a version that supports your current `TargetFramework`.
+// 3. If the dependency is a project reference, add the required `TargetFramework` to that project.
+//
+// Read more:
+// - https://learn.microsoft.com/en-us/nuget/reference/errors-and-warnings/nu1202
+func NewIncompatibleTargetFrameworkError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-dotnet-0015",
+    Title:      "Dependency target framework not supported",
+    Description: "Your project failed to restore dependencies with NuGet error NU1202 because a dependency does not support the current `TargetFramework`. This means the dependency lacks assets for your project's framework.\n\nTo resolve this, perform one of the following actions:\n\n1. Change your project's `TargetFramework` to one the dependency supports.\n2. Update the dependency to a version that supports your current `TargetFramework`.\n3. If the dependency is a project reference, add the required `TargetFramework` to that project.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-DOTNET-0015",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://learn.microsoft.com/en-us/nuget/reference/errors-and-warnings/nu1202",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
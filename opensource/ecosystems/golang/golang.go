@@ -0,0 +1,590 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package golang contains errors related to the group Go of the namespace
+// OpenSourceEcosystems of the Error Catalog.
+package golang
+
+import (
+  "github.com/snyk/error-catalog-golang-public/snyk_errors"
+  "github.com/google/uuid"
+)
+// NewPrivateModuleError displays errors with the following description:
+// Snyk could not access the private modules within your go.mod files.
+//
+// Read more:
+// - https://docs.snyk.io/scan-applications/supported-languages-and-frameworks/go
+func NewPrivateModuleError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0001",
+    Title:      "Failed to access private module",
+    Description: "Snyk could not access the private modules within your go.mod files.",
+    StatusCode: 400,
+    ErrorCode:  "SNYK-OS-GO-0001",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.snyk.io/scan-applications/supported-languages-and-frameworks/go",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGoModFileMissingError displays errors with the following description:
+// A go.mod file was not found in the current directory or any parent directory.
+//
+// Read more:
+// - https://docs.snyk.io/scan-applications/supported-languages-and-frameworks/go
+func NewGoModFileMissingError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0002",
+    Title:      "Go mod file not found",
+    Description: "A go.mod file was not found in the current directory or any parent directory.",
+    StatusCode: 400,
+    ErrorCode:  "SNYK-OS-GO-0002",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.snyk.io/scan-applications/supported-languages-and-frameworks/go",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewSsoReAuthRequiredError displays errors with the following description:
+// Your code is cloned on an isolated environment using Git as it is required by Snyk to analyze its dependencies.
+// 
+// Your Organization has enabled or enforced SAML SSO after you authorized Snyk to access your code, and a re-authentication is therefore required.
+// 
+// The error you're seeing is usually reproducible by attempting to do a `git clone` of your repository with incorrectly configured credentials.
+// Verify your authentication configuration with your Git cloud provider and try again.
+//
+// Read more:
+// - https://docs.github.com/en/enterprise-cloud@latest/authentication/authenticating-with-saml-single-sign-on/about-authentication-with-saml-single-sign-on#about-oauth-apps-github-apps-and-saml-sso
+//
+// Deprecated: This error has been moved to a more generalized namespace to avoid repetition.  Use SNYK-OS-8004 instead.
+func NewSsoReAuthRequiredError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0003",
+    Title:      "OAuth re-authorization required",
+    Description: "Your code is cloned on an isolated environment using Git as it is required by Snyk to analyze its dependencies.\n\nYour Organization has enabled or enforced SAML SSO after you authorized Snyk to access your code, and a re-authentication is therefore required.\n\nThe error you're seeing is usually reproducible by attempting to do a `git clone` of your repository with incorrectly configured credentials.\nVerify your authentication configuration with your Git cloud provider and try again.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0003",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.github.com/en/enterprise-cloud@latest/authentication/authenticating-with-saml-single-sign-on/about-authentication-with-saml-single-sign-on#about-oauth-apps-github-apps-and-saml-sso",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewIncompleteProjectError displays errors with the following description:
+// Generating the dependency graph requires Snyk to run go list `go list -deps -json` inside the project. If the operation fails, creating a full dependency graph cannot continue.  
+// 
+// This error means that you need some cleanup, (such as `go mod tidy`) or your project deployment process contains a code generation step such as `protobuf` or similar that is not currently supported by Snyk. 
+// 
+// To verify if this is the case, clone your project in a clean environment, run go list `go list -deps -json` and verify whether the operation fails. 
+// 
+// If Snyk cannot process your code successfully, insert the Snyk CLI as part of your deployment pipeline.
+//
+// Read more:
+// - https://docs.snyk.io/snyk-cli
+// - https://github.com/snyk/snyk-go-plugin
+// - https://github.com/golang/go/blob/master/src/cmd/go/internal/list/list.go
+func NewIncompleteProjectError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0004",
+    Title:      "Your project repository is missing required files",
+    Description: "Generating the dependency graph requires Snyk to run go list `go list -deps -json` inside the project. If the operation fails, creating a full dependency graph cannot continue.  \n\nThis error means that you need some cleanup, (such as `go mod tidy`) or your project deployment process contains a code generation step such as `protobuf` or similar that is not currently supported by Snyk. \n\nTo verify if this is the case, clone your project in a clean environment, run go list `go list -deps -json` and verify whether the operation fails. \n\nIf Snyk cannot process your code successfully, insert the Snyk CLI as part of your deployment pipeline.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0004",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.snyk.io/snyk-cli",
+      "https://github.com/snyk/snyk-go-plugin",
+      "https://github.com/golang/go/blob/master/src/cmd/go/internal/list/list.go",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewInconsistentVendoringError displays errors with the following description:
+// Generating the dependency graph requires Snyk to run `go list -deps -json` inside the project. If the operation fails, creating a full dependency graph cannot continue.  
+// 
+// This error means that there is inconsistency between your `vendor/modules.txt` file and your `go.mod` file. To remediate, you need to:
+// 
+// * `go mod vendor`
+// * `go mod tidy`
+// 
+// Next, commit those changes to your repo. Snyk does not manipulate with your code on our end by design, which is why this is not done automatically.
+// 
+// To verify if this is the case, clone your project in a clean environment, run go list `go list -deps -json` and verify whether the operation fails. 
+// Then try and run the above mentioned commands and see if your SCM system reports changes in files.
+//
+// Read more:
+// - https://go.dev/ref/mod#go-mod-vendor
+func NewInconsistentVendoringError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0005",
+    Title:      "Your project repository has inconsistent vendoring information",
+    Description: "Generating the dependency graph requires Snyk to run `go list -deps -json` inside the project. If the operation fails, creating a full dependency graph cannot continue.  \n\nThis error means that there is inconsistency between your `vendor/modules.txt` file and your `go.mod` file. To remediate, you need to:\n\n* `go mod vendor`\n* `go mod tidy`\n\nNext, commit those changes to your repo. Snyk does not manipulate with your code on our end by design, which is why this is not done automatically.\n\nTo verify if this is the case, clone your project in a clean environment, run go list `go list -deps -json` and verify whether the operation fails. \nThen try and run the above mentioned commands and see if your SCM system reports changes in files.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0005",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://go.dev/ref/mod#go-mod-vendor",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnsupportedExternalFileGenerationSCMError displays errors with the following description:
+// Snyk currently does not support external file generation in your project. This limitation is due to Snyk's lack of visibility into the third-party generator tools you may be using and the specific commands required to generate these files.
+// 
+// Snyk can only work with the files available in your repository and does not have insight into the generation process for external files.
+func NewUnsupportedExternalFileGenerationSCMError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0006",
+    Title:      "Unsupported external file generation",
+    Description: "Snyk currently does not support external file generation in your project. This limitation is due to Snyk's lack of visibility into the third-party generator tools you may be using and the specific commands required to generate these files.\n\nSnyk can only work with the files available in your repository and does not have insight into the generation process for external files.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0006",
+    Classification: "UNSUPPORTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnableToAccessPrivateDepsError displays errors with the following description:
+// The Go tool encountered a `DepsError` while trying to download a private dependency. Private repositories that are not accessible to the public internet and are not available on the official Go proxy mirror are cloned with a version control system and built on demand. 
+// This requires the VCS to have the correct access rights to that repository.
+// 
+// Snyk supports private repositories that are hosted in the same Organization and on the same Project that is scanned for vulnerabilities. The authentication to the private repository is the same as the authentication used to integrate that repository with Snyk. 
+// 
+// This error appears when the authorization credentials do not allow access to the requested private dependency. 
+//
+// Read more:
+// - https://go.dev/ref/mod#vcs
+func NewUnableToAccessPrivateDepsError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0007",
+    Title:      "Unable to access private dependencies",
+    Description: "The Go tool encountered a `DepsError` while trying to download a private dependency. Private repositories that are not accessible to the public internet and are not available on the official Go proxy mirror are cloned with a version control system and built on demand. \nThis requires the VCS to have the correct access rights to that repository.\n\nSnyk supports private repositories that are hosted in the same Organization and on the same Project that is scanned for vulnerabilities. The authentication to the private repository is the same as the authentication used to integrate that repository with Snyk. \n\nThis error appears when the authorization credentials do not allow access to the requested private dependency. ",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0007",
+    Classification: "UNSUPPORTED",
+    Links: []string{
+      "https://go.dev/ref/mod#vcs",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnableToUseCredentialsError displays errors with the following description:
+// The Go tool encountered a permissions error while fetching one of the private dependencies. Ensure that the integration token you used to sign in to Snyk is properly configured so that Snyk can access the private dependencies.
+// 
+// The Snyk Go integration only supports private dependencies that are used inside the same Organization as the Project you are scanning.
+// 
+// This error appears when Snyk is unable to properly access the authorization credentials for the requested private dependency. 
+func NewUnableToUseCredentialsError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0008",
+    Title:      "Unable to fetch private dependencies",
+    Description: "The Go tool encountered a permissions error while fetching one of the private dependencies. Ensure that the integration token you used to sign in to Snyk is properly configured so that Snyk can access the private dependencies.\n\nThe Snyk Go integration only supports private dependencies that are used inside the same Organization as the Project you are scanning.\n\nThis error appears when Snyk is unable to properly access the authorization credentials for the requested private dependency. ",
+    StatusCode: 401,
+    ErrorCode:  "SNYK-OS-GO-0008",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewToolchainNotAvailableError displays errors with the following description:
+// Could not download Go toolchain.
+func NewToolchainNotAvailableError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0009",
+    Title:      "Toolchain not available",
+    Description: "Could not download Go toolchain.",
+    StatusCode: 500,
+    ErrorCode:  "SNYK-OS-GO-0009",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangSpaceLimitExceededError displays errors with the following description:
+// The total size of the downloaded Golang dependencies in the manifest file exceeds the 10GB limit.
+// This often happens due to the large size or large number of Golang dependencies.
+// 
+// Currently this is a product limitation for SCM. As a workaround, use the 'snyk monitor' command via Snyk CLI.
+//
+// Read more:
+// - https://docs.snyk.io/snyk-cli/commands/monitor
+func NewGolangSpaceLimitExceededError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0010",
+    Title:      "The 10 GB space limit for downloaded Golang dependencies has been exceeded",
+    Description: "The total size of the downloaded Golang dependencies in the manifest file exceeds the 10GB limit.\nThis often happens due to the large size or large number of Golang dependencies.\n\nCurrently this is a product limitation for SCM. As a workaround, use the 'snyk monitor' command via Snyk CLI.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0010",
+    Classification: "UNSUPPORTED",
+    Links: []string{
+      "https://docs.snyk.io/snyk-cli/commands/monitor",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangNoSecureProtocolFoundError displays errors with the following description:
+// The Go toolchain could not find a secure protocol (HTTPS) to access the repository.
+// 
+// This error typically occurs when the repository URL is not configured to use a secure protocol, or the necessary credentials for accessing the repository securely are not provided.
+// 
+// Ensure that the repository URL uses a secure protocol (https://) and verify that the necessary authentication credentials (such as HTTPS credentials) are correctly configured and accessible by the Go toolchain.
+//  
+// Note: SSH is not supported.
+func NewGolangNoSecureProtocolFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0011",
+    Title:      "No secure protocol found for repository",
+    Description: "The Go toolchain could not find a secure protocol (HTTPS) to access the repository.\n\nThis error typically occurs when the repository URL is not configured to use a secure protocol, or the necessary credentials for accessing the repository securely are not provided.\n\nEnsure that the repository URL uses a secure protocol (https://) and verify that the necessary authentication credentials (such as HTTPS credentials) are correctly configured and accessible by the Go toolchain.\n \nNote: SSH is not supported.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0011",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangConnectionResetByPeerError displays errors with the following description:
+// The Go toolchain encountered a connection reset error while trying to access the repository.
+// 
+// This error typically occurs when the connection to the repository is unexpectedly closed by the remote server. This can be due to network issues, server configuration, or other transient problems.
+// 
+// Try to reimport the project, if that does not work, please reach out to Snyk support.
+func NewGolangConnectionResetByPeerError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0012",
+    Title:      "Connection reset by peer",
+    Description: "The Go toolchain encountered a connection reset error while trying to access the repository.\n\nThis error typically occurs when the connection to the repository is unexpectedly closed by the remote server. This can be due to network issues, server configuration, or other transient problems.\n\nTry to reimport the project, if that does not work, please reach out to Snyk support.",
+    StatusCode: 500,
+    ErrorCode:  "SNYK-OS-GO-0012",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangInvalidZipFileError displays errors with the following description:
+// The Go toolchain encountered an error while trying to download and extract a Go package version. The downloaded file is not a valid zip file.
+// 
+// This error typically occurs when there is an issue with the downloaded file, such as corruption or an incomplete download.
+// 
+// If the package that fails is a private package that's hosted in a private network, make sure the network is up and running and retry the import. If the issue persists, verify the integrity of the downloaded file and check for any issues with the source of the download.
+// 
+// If the issue persists, please reach out to Snyk support.
+func NewGolangInvalidZipFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0013",
+    Title:      "Invalid zip file",
+    Description: "The Go toolchain encountered an error while trying to download and extract a Go package version. The downloaded file is not a valid zip file.\n\nThis error typically occurs when there is an issue with the downloaded file, such as corruption or an incomplete download.\n\nIf the package that fails is a private package that's hosted in a private network, make sure the network is up and running and retry the import. If the issue persists, verify the integrity of the downloaded file and check for any issues with the source of the download.\n\nIf the issue persists, please reach out to Snyk support.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0013",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangVersionMismatchError displays errors with the following description:
+// The Go toolchain encountered a version mismatch error while trying to process the module.
+// 
+// This usually happens when the version of Go that was used in the go.mod file is not yet supported by Snyk.
+// 
+// We usually try and add support for new Golang versions shortly after a new one was released.
+// 
+// If the Go version used in the go.mod file is supported based on our Golang documentation, please reach out to Snyk support.
+//
+// Read more:
+// - https://docs.snyk.io/supported-languages-package-managers-and-frameworks/go/go-for-open-source#go-for-snyk-open-source-support
+func NewGolangVersionMismatchError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0014",
+    Title:      "Go version mismatch",
+    Description: "The Go toolchain encountered a version mismatch error while trying to process the module.\n\nThis usually happens when the version of Go that was used in the go.mod file is not yet supported by Snyk.\n\nWe usually try and add support for new Golang versions shortly after a new one was released.\n\nIf the Go version used in the go.mod file is supported based on our Golang documentation, please reach out to Snyk support.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0014",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.snyk.io/supported-languages-package-managers-and-frameworks/go/go-for-open-source#go-for-snyk-open-source-support",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangInvalidGoVersionError displays errors with the following description:
+// The Go toolchain encountered an error while parsing the go.mod file. The specified Go version '__GOLANG_VERSION__' is invalid and must match the format 1.23.4.
+// 
+// This error typically occurs when the Go version in the go.mod file is not correctly formatted.
+// 
+// Ensure that the Go version specified in the go.mod file matches the required format (e.g., 1.23.4). Update the go.mod file with the correct Go version and try again.
+func NewGolangInvalidGoVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0015",
+    Title:      "Invalid Go version in go.mod",
+    Description: "The Go toolchain encountered an error while parsing the go.mod file. The specified Go version '__GOLANG_VERSION__' is invalid and must match the format 1.23.4.\n\nThis error typically occurs when the Go version in the go.mod file is not correctly formatted.\n\nEnsure that the Go version specified in the go.mod file matches the required format (e.g., 1.23.4). Update the go.mod file with the correct Go version and try again.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0015",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangDialTcpTimeoutError displays errors with the following description:
+// The Go toolchain encountered a timeout error while trying to fetch a module/package.
+// 
+// This error typically occurs when the connection to the server hosting the module/package times out. This can be due to network issues, server configuration, or the server being unreachable.
+// 
+// Ensure that the server hosting the module is reachable and that there are no network issues. If the issue persists, check if there are any restrictions or firewalls blocking access to the server.
+func NewGolangDialTcpTimeoutError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0016",
+    Title:      "Dial TCP timeout",
+    Description: "The Go toolchain encountered a timeout error while trying to fetch a module/package.\n\nThis error typically occurs when the connection to the server hosting the module/package times out. This can be due to network issues, server configuration, or the server being unreachable.\n\nEnsure that the server hosting the module is reachable and that there are no network issues. If the issue persists, check if there are any restrictions or firewalls blocking access to the server.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0016",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangHostKeyVerificationFailedError displays errors with the following description:
+// The Go toolchain encountered an error while trying to fetch a module/package. The host key verification failed, which means the key used to connect to the remote repository could not be verified.
+// 
+// This error typically occurs when the key is not recognized or is incorrect. It can also happen if the remote repository's host key has changed.
+// 
+// Ensure that the key used to connect to the remote repository is correct and properly configured. You may need to update the known_hosts file to include the correct host key for the remote repository. Verify your repository access configuration and try again. 
+//
+// Read more:
+// - https://docs.github.com/en/authentication/troubleshooting-ssh/error-host-key-verification-failed
+func NewGolangHostKeyVerificationFailedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0017",
+    Title:      "Host key verification failed",
+    Description: "The Go toolchain encountered an error while trying to fetch a module/package. The host key verification failed, which means the key used to connect to the remote repository could not be verified.\n\nThis error typically occurs when the key is not recognized or is incorrect. It can also happen if the remote repository's host key has changed.\n\nEnsure that the key used to connect to the remote repository is correct and properly configured. You may need to update the known_hosts file to include the correct host key for the remote repository. Verify your repository access configuration and try again. ",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0017",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.github.com/en/authentication/troubleshooting-ssh/error-host-key-verification-failed",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangMissingModuleDeclarationError displays errors with the following description:
+// The Go toolchain encountered an error while reading the go.mod file. The error indicates that the module declaration is missing.
+// 
+// This error typically occurs when the go.mod file does not specify the module path. To resolve this issue, you need to add a module declaration to the go.mod file.
+// 
+// To specify the module path, run the following command: go mod edit -module=example.com/mod
+// 
+// Update the go.mod file with the correct module path and try again.
+func NewGolangMissingModuleDeclarationError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0018",
+    Title:      "Missing module declaration in go.mod",
+    Description: "The Go toolchain encountered an error while reading the go.mod file. The error indicates that the module declaration is missing.\n\nThis error typically occurs when the go.mod file does not specify the module path. To resolve this issue, you need to add a module declaration to the go.mod file.\n\nTo specify the module path, run the following command: go mod edit -module=example.com/mod\n\nUpdate the go.mod file with the correct module path and try again.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0018",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGolangModuleVersionConstraintNotMetError displays errors with the following description:
+// The Go toolchain encountered a version constraint violation while resolving dependencies.
+// 
+// This error typically occurs when a module or dependency specifies a version requirement that is not satisfied by the current Go version in use. For example, a module may require "go >= 1.22.0", but your environment is running "go 1.21.0".
+// 
+// To resolve this, ensure your Go toolchain version meets the module's specified constraint. This might involve upgrading or downgrading your Go version from Settings -> Snyk Open Source -> Edit settings for Go, or finding an alternative version of the module that is compatible with your current Go environment.
+func NewGolangModuleVersionConstraintNotMetError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-go-0019",
+    Title:      "Go module version constraint not met",
+    Description: "The Go toolchain encountered a version constraint violation while resolving dependencies.\n\nThis error typically occurs when a module or dependency specifies a version requirement that is not satisfied by the current Go version in use. For example, a module may require \"go >= 1.22.0\", but your environment is running \"go 1.21.0\".\n\nTo resolve this, ensure your Go toolchain version meets the module's specified constraint. This might involve upgrading or downgrading your Go version from Settings -> Snyk Open Source -> Edit settings for Go, or finding an alternative version of the module that is compatible with your current Go environment.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-GO-0019",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
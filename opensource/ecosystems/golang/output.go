@@ -0,0 +1,85 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package golang
+
+import (
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/isolatedbuilds"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/internal/output"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// outputRules are tried in order, so more specific rules come first. For
+// example a failing git clone reports both the reason, such as SAML SSO
+// enforcement, and a generic fatal error.
+var outputRules = []output.Rule{
+	output.NewRule(`go\.mod file not found in current directory or any parent directory`, NewGoModFileMissingError),
+	output.NewRule(`error reading go\.mod: missing module declaration`, NewGolangMissingModuleDeclarationError),
+	output.NewRule(`invalid go version '[^']*': must match format`, NewGolangInvalidGoVersionError),
+	output.NewRule(`toolchain not available`, NewToolchainNotAvailableError),
+	output.NewRule(`go\.mod requires go >= \S+ \(running go`, NewGolangVersionMismatchError),
+	output.NewRule(`\S+@\S+ requires go >= \S+ \(running go`, NewGolangModuleVersionConstraintNotMetError),
+	output.NewRule(`inconsistent vendoring`, NewInconsistentVendoringError),
+	output.NewRule(`no space left on device`, NewGolangSpaceLimitExceededError),
+	output.NewRule(`enabled or enforced SAML SSO`, isolatedbuilds.NewSsoReAuthRequiredError),
+	output.NewRule(`verifying (module|go\.mod): .*(404 Not Found|410 Gone)`, NewPrivateModuleError),
+	output.NewRule(`Host key verification failed`, NewGolangHostKeyVerificationFailedError),
+	output.NewRule(`no secure protocol found for repository`, NewGolangNoSecureProtocolFoundError),
+	output.NewRule(`terminal prompts disabled|could not read (Username|Password) for`, NewUnableToUseCredentialsError),
+	output.NewRule(`Repository not found|Authentication failed for|Permission denied \(publickey\)|The requested URL returned error: 40[134]`, NewUnableToAccessPrivateDepsError),
+	output.NewRule(`If this is a private repository`, NewPrivateModuleError),
+	output.NewRule(`zip: not a valid zip file`, NewGolangInvalidZipFileError),
+	output.NewRule(`dial tcp \S+: i/o timeout`, NewGolangDialTcpTimeoutError),
+	output.NewRule(`connection reset by peer`, NewGolangConnectionResetByPeerError),
+	output.NewRule(`is not in std \(`, NewUnsupportedExternalFileGenerationSCMError),
+	output.NewRule(`no required module provides package|missing go\.sum entry|updates to go\.mod needed|go: updates to go\.sum needed`, NewIncompleteProjectError),
+}
+
+// ParseOutput returns the catalog error matching the combined output of a go
+// command, such as go list or go mod download, which exited with exitCode.
+// The detail of the error is the line which matched, and its Logs hold the
+// whole message containing that line, including the indented lines the go
+// command uses to continue a message.
+//
+// It returns false if the command succeeded or the output matches no known
+// error.
+func ParseOutput(output string, exitCode int) (snyk_errors.Error, bool) {
+	return parser.Parse(output, exitCode)
+}
+
+var parser = output.Parser{Rules: outputRules, Logs: messageAt}
+
+// messageAt returns the message containing line i: the closest line at or
+// before i which is not indented, followed by the indented lines continuing
+// it.
+func messageAt(lines []string, i int) []string {
+	start := i
+	for start > 0 && isContinuation(lines[start]) {
+		start--
+	}
+
+	end := i + 1
+	for end < len(lines) && (isContinuation(lines[end]) || lines[end] == "" && end+1 < len(lines) && isContinuation(lines[end+1])) {
+		end++
+	}
+
+	return append([]string(nil), lines[start:end]...)
+}
+
+func isContinuation(line string) bool {
+	return strings.HasPrefix(line, "\t") || strings.HasPrefix(line, " ")
+}
@@ -0,0 +1,67 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package golang_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/golang"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/internal/output/outputtest"
+)
+
+func TestParseOutput_Fixtures(t *testing.T) {
+	fixtures, err := outputtest.LoadFixtures(filepath.Join("testdata", "output"))
+	require.NoError(t, err)
+
+	for _, f := range fixtures {
+		t.Run(f.Name, func(t *testing.T) {
+			require.NoError(t, f.Check(golang.ParseOutput(f.Output, f.ExitCode)))
+		})
+	}
+}
+
+// TestParseOutput_Coverage requires a fixture for every error of the Go
+// group, or for its replacement if it is deprecated.
+func TestParseOutput_Coverage(t *testing.T) {
+	fixtures, err := outputtest.LoadFixtures(filepath.Join("testdata", "output"))
+	require.NoError(t, err)
+
+	var codes []string
+	for _, entry := range catalog.All() {
+		if entry.Namespace == "OpenSourceEcosystems" && entry.Group == "Go" {
+			codes = append(codes, catalog.Canonical(entry.Code))
+		}
+	}
+
+	require.Empty(t, outputtest.Uncovered(fixtures, codes))
+}
+
+func TestParseOutput(t *testing.T) {
+	output := "go: downloading github.com/google/uuid v1.6.0\r\n" +
+		"go: go.mod file not found in current directory or any parent directory; see 'go help modules'\r\n"
+
+	actual, ok := golang.ParseOutput(output, 1)
+	require.True(t, ok)
+	require.Equal(t, "go: go.mod file not found in current directory or any parent directory; see 'go help modules'", actual.Detail)
+	require.Equal(t, []string{actual.Detail}, actual.Logs)
+
+	_, ok = golang.ParseOutput(output, 0)
+	require.False(t, ok)
+}
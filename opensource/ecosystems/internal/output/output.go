@@ -0,0 +1,100 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package output matches the output of the build tools run to resolve
+// dependencies, such as the go command or Maven, to catalog errors. Each
+// ecosystem declares its Parser; this package holds the matching. The fixtures
+// which back the rules are loaded by package outputtest.
+package output
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// Rule maps a line of output to a catalog error. The values of the named
+// groups of Pattern are set in the meta of the error, keyed by group name.
+type Rule struct {
+	Pattern *regexp.Regexp
+	New     func(detail string, options ...snyk_errors.Option) snyk_errors.Error
+}
+
+// NewRule returns the Rule creating errors with new for the lines matching
+// pattern. It panics if pattern is not a valid regular expression.
+func NewRule(pattern string, new func(detail string, options ...snyk_errors.Option) snyk_errors.Error) Rule {
+	return Rule{Pattern: regexp.MustCompile(pattern), New: new}
+}
+
+// Parser matches the output of a tool to catalog errors.
+type Parser struct {
+	// Rules are tried in order, each against every line, so that more
+	// specific rules come first.
+	Rules []Rule
+	// Detail returns the detail of the error from the line which matched. It
+	// defaults to the line without surrounding white space.
+	Detail func(line string) string
+	// Logs returns the logs of the error for the line at index i which
+	// matched. The error has no logs if Logs is nil.
+	Logs func(lines []string, i int) []string
+}
+
+// Parse returns the error of the first rule matching a line of output, a tool
+// run which exited with exitCode. Lines may end with \r\n.
+//
+// It returns false if the tool succeeded or no rule matches.
+func (p Parser) Parse(output string, exitCode int) (snyk_errors.Error, bool) {
+	if exitCode == 0 {
+		return snyk_errors.Error{}, false
+	}
+
+	lines := Lines(output)
+
+	for _, rule := range p.Rules {
+		for i, line := range lines {
+			match := rule.Pattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+
+			var options []snyk_errors.Option
+			if p.Logs != nil {
+				options = append(options, snyk_errors.WithLogs(p.Logs(lines, i)))
+			}
+
+			for j, name := range rule.Pattern.SubexpNames() {
+				if name != "" && match[j] != "" {
+					options = append(options, snyk_errors.WithMeta(name, match[j]))
+				}
+			}
+
+			detail := strings.TrimSpace(line)
+			if p.Detail != nil {
+				detail = p.Detail(line)
+			}
+
+			return rule.New(detail, options...), true
+		}
+	}
+
+	return snyk_errors.Error{}, false
+}
+
+// Lines splits output into lines, accepting both \n and \r\n line endings.
+func Lines(output string) []string {
+	return strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,48 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestParser_Parse(t *testing.T) {
+	parser := Parser{Rules: []Rule{
+		NewRule(`cannot find (?P<name>\S+)`, func(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+			err := snyk_errors.Error{ErrorCode: "SNYK-OS-GO-0001", Detail: detail}
+			for _, option := range options {
+				option(&err)
+			}
+			return err
+		}),
+	}}
+
+	actual, ok := parser.Parse("starting\r\n  cannot find widget\r\n", 1)
+	require.True(t, ok)
+	require.Equal(t, "cannot find widget", actual.Detail)
+	require.Equal(t, "widget", actual.Meta["name"])
+
+	_, ok = parser.Parse("starting\r\n  cannot find widget\r\n", 0)
+	require.False(t, ok)
+
+	_, ok = parser.Parse("starting\r\n", 1)
+	require.False(t, ok)
+}
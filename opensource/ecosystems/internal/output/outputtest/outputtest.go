@@ -0,0 +1,217 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package outputtest loads the fixtures which back the rules of the output
+// parsers, for use in the tests of the ecosystem packages.
+package outputtest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// Fixture is a sample of tool output with the error it is expected to parse
+// to. Fixtures are txtar archives, see golang.org/x/tools/txtar, whose
+// comment holds "key: value" lines:
+//
+//	code   the expected error code, or none if the output matches no rule
+//	exit   the exit code of the tool
+//	detail the expected detail, which defaults to a line of the output
+//
+// The archive holds the output in a file named output, and optionally the
+// expected logs, one per line, in a file named logs and the expected meta, as
+// "key: value" lines, in a file named meta.
+type Fixture struct {
+	Name     string
+	Code     string
+	ExitCode int
+	Detail   string
+	Output   string
+	Logs     []string
+	Meta     map[string]any
+}
+
+// LoadFixtures loads the fixtures of the *.txtar files in dir.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.txtar"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no fixtures in %s", dir)
+	}
+
+	fixtures := make([]Fixture, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := parseFixture(strings.TrimSuffix(filepath.Base(path), ".txtar"), string(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		fixtures = append(fixtures, f)
+	}
+
+	return fixtures, nil
+}
+
+func parseFixture(name, data string) (Fixture, error) {
+	comment, files := parseArchive(data)
+
+	f := Fixture{Name: name}
+	for key, value := range keyValues(comment) {
+		switch key {
+		case "code":
+			f.Code = value
+		case "exit":
+			exitCode, err := strconv.Atoi(value)
+			if err != nil {
+				return Fixture{}, fmt.Errorf("invalid exit code: %w", err)
+			}
+			f.ExitCode = exitCode
+		case "detail":
+			f.Detail = value
+		}
+	}
+
+	if f.Code == "" {
+		return Fixture{}, fmt.Errorf("no code")
+	}
+
+	output, ok := files["output"]
+	if !ok {
+		return Fixture{}, fmt.Errorf("no output file")
+	}
+	f.Output = output
+
+	if logs, ok := files["logs"]; ok {
+		f.Logs = strings.Split(strings.TrimSuffix(logs, "\n"), "\n")
+	}
+
+	if meta, ok := files["meta"]; ok {
+		f.Meta = make(map[string]any)
+		for key, value := range keyValues(meta) {
+			f.Meta[key] = value
+		}
+	}
+
+	return f, nil
+}
+
+// parseArchive splits a txtar archive into its comment and files. A file
+// starts at a "-- name --" line and ends at the next one.
+func parseArchive(data string) (string, map[string]string) {
+	var comment string
+	files := make(map[string]string)
+
+	name, text := "", &strings.Builder{}
+	flush := func() {
+		if name == "" {
+			comment = text.String()
+		} else {
+			files[name] = text.String()
+		}
+	}
+
+	for _, line := range strings.SplitAfter(data, "\n") {
+		if marker, ok := fileMarker(line); ok {
+			flush()
+			name, text = marker, &strings.Builder{}
+			continue
+		}
+		text.WriteString(line)
+	}
+	flush()
+
+	return comment, files
+}
+
+func fileMarker(line string) (string, bool) {
+	line = strings.TrimRight(line, "\r\n")
+	if !strings.HasPrefix(line, "-- ") || !strings.HasSuffix(line, " --") || len(line) < 7 {
+		return "", false
+	}
+
+	return strings.TrimSpace(line[3 : len(line)-3]), true
+}
+
+// keyValues parses "key: value" lines, skipping other lines such as comments.
+func keyValues(text string) map[string]string {
+	values := make(map[string]string)
+	for _, line := range strings.Split(text, "\n") {
+		if key, value, ok := strings.Cut(line, ": "); ok && !strings.HasPrefix(key, "#") {
+			values[key] = value
+		}
+	}
+
+	return values
+}
+
+// Check returns an error describing how the result of parsing the output of
+// f differs from the expected one, or nil if it does not.
+func (f Fixture) Check(actual snyk_errors.Error, ok bool) error {
+	if f.Code == "none" {
+		if ok {
+			return fmt.Errorf("expected no error, got %s", actual.ErrorCode)
+		}
+		return nil
+	}
+
+	switch {
+	case !ok:
+		return fmt.Errorf("expected %s, got no error", f.Code)
+	case actual.ErrorCode != f.Code:
+		return fmt.Errorf("expected %s, got %s", f.Code, actual.ErrorCode)
+	case f.Detail != "" && actual.Detail != f.Detail:
+		return fmt.Errorf("expected detail %q, got %q", f.Detail, actual.Detail)
+	case f.Detail == "" && (actual.Detail == "" || !strings.Contains(f.Output, actual.Detail)):
+		return fmt.Errorf("detail %q is not part of the output", actual.Detail)
+	case f.Logs != nil && !reflect.DeepEqual(f.Logs, actual.Logs):
+		return fmt.Errorf("expected logs %q, got %q", f.Logs, actual.Logs)
+	case f.Meta != nil && !reflect.DeepEqual(f.Meta, actual.Meta):
+		return fmt.Errorf("expected meta %v, got %v", f.Meta, actual.Meta)
+	}
+
+	return nil
+}
+
+// Uncovered returns the codes of codes which no fixture expects.
+func Uncovered(fixtures []Fixture, codes []string) []string {
+	covered := make(map[string]bool, len(fixtures))
+	for _, f := range fixtures {
+		covered[f.Code] = true
+	}
+
+	var uncovered []string
+	for _, code := range codes {
+		if !covered[code] {
+			uncovered = append(uncovered, code)
+		}
+	}
+
+	return uncovered
+}
@@ -0,0 +1,57 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package outputtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestParseFixture(t *testing.T) {
+	f, err := parseFixture("sample", "# a comment\ncode: SNYK-OS-GO-0001\nexit: 1\n-- output --\nline 1\r\nline 2\n-- logs --\nline 1\n-- meta --\nkey: value\n")
+	require.NoError(t, err)
+	require.Equal(t, Fixture{
+		Name:     "sample",
+		Code:     "SNYK-OS-GO-0001",
+		ExitCode: 1,
+		Output:   "line 1\r\nline 2\n",
+		Logs:     []string{"line 1"},
+		Meta:     map[string]any{"key": "value"},
+	}, f)
+
+	_, err = parseFixture("sample", "exit: 1\n-- output --\n")
+	require.ErrorContains(t, err, "no code")
+
+	_, err = parseFixture("sample", "code: none\n")
+	require.ErrorContains(t, err, "no output file")
+}
+
+func TestFixture_Check(t *testing.T) {
+	f := Fixture{Code: "SNYK-OS-GO-0001", Output: "go: some failure\n"}
+	matched := snyk_errors.Error{ErrorCode: "SNYK-OS-GO-0001", Detail: "go: some failure"}
+
+	require.NoError(t, f.Check(matched, true))
+	require.Error(t, f.Check(snyk_errors.Error{}, false))
+	require.Error(t, f.Check(snyk_errors.Error{ErrorCode: "SNYK-OS-GO-0002", Detail: "go: some failure"}, true))
+	require.Error(t, f.Check(snyk_errors.Error{ErrorCode: "SNYK-OS-GO-0001", Detail: "another failure"}, true))
+
+	require.NoError(t, Fixture{Code: "none"}.Check(snyk_errors.Error{}, false))
+	require.Error(t, Fixture{Code: "none"}.Check(matched, true))
+}
@@ -0,0 +1,457 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package maven contains errors related to the group Maven of the namespace
+// OpenSourceEcosystems of the Error Catalog.
+package maven
+
+import (
+  "github.com/snyk/error-catalog-golang-public/snyk_errors"
+  "github.com/google/uuid"
+)
+// NewMissingRequirementFromPomError displays errors with the following description:
+// The required property is missing from the pom object.
+func NewMissingRequirementFromPomError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0001",
+    Title:      "Missing property",
+    Description: "The required property is missing from the pom object.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0001",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnableToResolveValueForPropertyError displays errors with the following description:
+// The targeted property could not be resolved with a valid value.
+func NewUnableToResolveValueForPropertyError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0002",
+    Title:      "Unable to resolve value for property",
+    Description: "The targeted property could not be resolved with a valid value.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0002",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnableToResolveVersionForPropertyError displays errors with the following description:
+// The targeted property could not be resolved with a valid version.
+func NewUnableToResolveVersionForPropertyError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0003",
+    Title:      "Unable to resolve version for property",
+    Description: "The targeted property could not be resolved with a valid version.",
+    StatusCode: 500,
+    ErrorCode:  "SNYK-OS-MAVEN-0003",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCyclicPropertyDetectedInPomFileError displays errors with the following description:
+// There is circular dependency among properties in the Maven project's configuration file (POM), preventing proper resolution and causing an error.
+func NewCyclicPropertyDetectedInPomFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0004",
+    Title:      "Cyclic property detected in POM file",
+    Description: "There is circular dependency among properties in the Maven project's configuration file (POM), preventing proper resolution and causing an error.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0004",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnableToParseXMLError displays errors with the following description:
+// There is an error parsing the XML file. This could be referring to either pom.xml or maven-metadata.xml.
+func NewUnableToParseXMLError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0005",
+    Title:      "Error parsing the XML file",
+    Description: "There is an error parsing the XML file. This could be referring to either pom.xml or maven-metadata.xml.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0005",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewInvalidCoordinatesError displays errors with the following description:
+// The coordinates provided for a project were invalid.
+func NewInvalidCoordinatesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0006",
+    Title:      "Invalid coordinates provided",
+    Description: "The coordinates provided for a project were invalid.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0006",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewSkippedGroupError displays errors with the following description:
+// Skipping a specific groupId starting due to remapped coordinates.
+func NewSkippedGroupError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0007",
+    Title:      "Skipping group",
+    Description: "Skipping a specific groupId starting due to remapped coordinates.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0007",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPomFileNotFoundError displays errors with the following description:
+// The pom file was not found in Maven repository.
+func NewPomFileNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0008",
+    Title:      "Pom file not found",
+    Description: "The pom file was not found in Maven repository.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0008",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewMissingProjectFromPomError displays errors with the following description:
+// A project element is missing from POM.
+func NewMissingProjectFromPomError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0009",
+    Title:      "Missing project from POM",
+    Description: "A project element is missing from POM.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0009",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCannotResolveTargetPomFromXmlError displays errors with the following description:
+// Cannot resolve the targeted POM from the input XML.
+func NewCannotResolveTargetPomFromXmlError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0010",
+    Title:      "Cannot resolve the target POM from the input XML",
+    Description: "Cannot resolve the targeted POM from the input XML.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0010",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCannotResolveTargetPomFromRepoError displays errors with the following description:
+// Cannot resolve the targeted POM from the repository.
+func NewCannotResolveTargetPomFromRepoError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0011",
+    Title:      "Cannot resolve the target POM from the repository",
+    Description: "Cannot resolve the targeted POM from the repository.",
+    StatusCode: 404,
+    ErrorCode:  "SNYK-OS-MAVEN-0011",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCannotGetBuildFileFromRepoError displays errors with the following description:
+// Cannot get the build file repository.
+func NewCannotGetBuildFileFromRepoError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0012",
+    Title:      "Cannot get the build file repository",
+    Description: "Cannot get the build file repository.",
+    StatusCode: 404,
+    ErrorCode:  "SNYK-OS-MAVEN-0012",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCannotCreateGitHostError displays errors with the following description:
+// Cannot create source URL.
+func NewCannotCreateGitHostError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0013",
+    Title:      "Unable to create hosted git info",
+    Description: "Cannot create source URL.",
+    StatusCode: 500,
+    ErrorCode:  "SNYK-OS-MAVEN-0013",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewNoReleasedVersionForVersionsRangeError displays errors with the following description:
+// There was no version released for the specified versions range.
+func NewNoReleasedVersionForVersionsRangeError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0014",
+    Title:      "No released version for versions range",
+    Description: "There was no version released for the specified versions range.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0014",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewSourceNotSupportedError displays errors with the following description:
+// The source used is not supported by fetcher. The supported sources are: github, bitbucket, gitlab.
+func NewSourceNotSupportedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0015",
+    Title:      "Source is not supported",
+    Description: "The source used is not supported by fetcher. The supported sources are: github, bitbucket, gitlab.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0015",
+    Classification: "UNSUPPORTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewTimeoutWhenProcessingTheDepTreeError displays errors with the following description:
+// There was an timeout when processing the dependency tree.
+func NewTimeoutWhenProcessingTheDepTreeError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0016",
+    Title:      "Timeout when processing the dependency tree",
+    Description: "There was an timeout when processing the dependency tree.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0016",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCannotReachConfiguredRepositoryError displays errors with the following description:
+// One or more of the Maven repositories configured under your organisations language settings cannot be reached.
+// 
+// This error can happen for a variety of reasons:
+// 
+// * If using broker it could be a misconfiguration in your broker client. Double check the username and password. 
+// * It could be network connectivity between the broker client and Snyk or between the broker client and the configured repository, check your firewall rules.
+// 
+// In order to solve this issue, refer to the specific details of this error message to identify which repository is causing issues. 
+//
+// Read more:
+// - https://docs.snyk.io/integrate-with-snyk/package-repository-integrations
+func NewCannotReachConfiguredRepositoryError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0017",
+    Title:      "Cannot reach one or more Maven repositories configured under your Snyk organisations language settings",
+    Description: "One or more of the Maven repositories configured under your organisations language settings cannot be reached.\n\nThis error can happen for a variety of reasons:\n\n* If using broker it could be a misconfiguration in your broker client. Double check the username and password. \n* It could be network connectivity between the broker client and Snyk or between the broker client and the configured repository, check your firewall rules.\n\nIn order to solve this issue, refer to the specific details of this error message to identify which repository is causing issues. ",
+    StatusCode: 404,
+    ErrorCode:  "SNYK-OS-MAVEN-0017",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.snyk.io/integrate-with-snyk/package-repository-integrations",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewFailedToBuildMavenProjectError displays errors with the following description:
+// Snyk cannot build the Maven dependency tree because Maven failed to process your pom.xml. 
+// This often happens due to invalid configurations, unresolved dependencies, or build failures.
+// Examine your Maven output to identify specific errors and verify your pom.xml for correct configurations.
+// For common troubleshooting steps, visit Troubleshoot Maven issues.
+//
+// Read more:
+// - https://docs.snyk.io/supported-languages/supported-languages-list/java-and-kotlin/git-repositories-with-maven-and-gradle#maven
+func NewFailedToBuildMavenProjectError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-maven-0018",
+    Title:      "Cannot build Maven dependency tree",
+    Description: "Snyk cannot build the Maven dependency tree because Maven failed to process your pom.xml. \nThis often happens due to invalid configurations, unresolved dependencies, or build failures.\nExamine your Maven output to identify specific errors and verify your pom.xml for correct configurations.\nFor common troubleshooting steps, visit Troubleshoot Maven issues.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-MAVEN-0018",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.snyk.io/supported-languages/supported-languages-list/java-and-kotlin/git-repositories-with-maven-and-gradle#maven",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
@@ -0,0 +1,151 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package maven
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/internal/output"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// Meta keys set by ParseOutput when the output names the offending element.
+const (
+	MetaKeyCoordinates   = "coordinates"
+	MetaKeyProperty      = "property"
+	MetaKeyField         = "field"
+	MetaKeyRepositoryID  = "repositoryId"
+	MetaKeyRepositoryURL = "repositoryUrl"
+	MetaKeyPOM           = "pom"
+	MetaKeyDirectory     = "directory"
+)
+
+// TimeoutExitCode is the exit code of commands killed by timeout(1), which
+// ParseOutput reports as NewTimeoutWhenProcessingTheDepTreeError.
+const TimeoutExitCode = 124
+
+// repository matches the repository of a failed download, such as
+// "in central (https://repo.maven.apache.org/maven2)".
+const repository = `(?P<repositoryId>[^\s(]+) \((?P<repositoryUrl>[^)\s]+)\)`
+
+// outputRules name the cause of a failure before the failure it leads to: an
+// unreachable repository fails the parent POM resolution, and every problem
+// ends in a build failure. The named groups of the patterns are meta keys.
+var outputRules = []output.Rule{
+	// Maven was run outside of the project. PomFileNotFound is the only
+	// error for a missing POM.
+	output.NewRule(`there is no POM in this directory \((?P<directory>[^)]*)\)`, NewPomFileNotFoundError),
+	output.NewRule(`Non-parseable POM (?P<pom>.+?): Expected root element 'project'`, NewMissingProjectFromPomError),
+	output.NewRule(`Non-parseable POM (?P<pom>.+?): `, NewUnableToParseXMLError),
+	output.NewRule(`Resolving expression: '\$\{(?P<property>[^}]+)\}': Detected the following recursive expression cycle`, NewCyclicPropertyDetectedInPomFileError),
+	output.NewRule(`'(?P<field>[\w.]*version)' for (?P<coordinates>\S+) must be a valid version but is '\$\{(?P<property>[^}]+)\}'`, NewUnableToResolveVersionForPropertyError),
+	output.NewRule(`'(?P<field>[\w.]+)' for (?P<coordinates>\S+) .*?\$\{(?P<property>[^}]+)\}`, NewUnableToResolveValueForPropertyError),
+	output.NewRule(`'(?P<field>[\w.]+)' for (?P<coordinates>\S+) with value '[^']*' does not match a valid id pattern`, NewInvalidCoordinatesError),
+	output.NewRule(`'(?P<field>[\w.]+)' for (?P<coordinates>\S+) is missing`, NewMissingRequirementFromPomError),
+	output.NewRule(`No versions available for (?P<coordinates>\S+) within specified range`, NewNoReleasedVersionForVersionsRangeError),
+	// The repository could not be reached at all, whichever artifact was
+	// being downloaded.
+	output.NewRule(`Could not transfer (?:artifact|metadata) (?P<coordinates>\S+) from/to `+repository, NewCannotReachConfiguredRepositoryError),
+	// The parent POM is declared in the pom.xml being scanned and is looked
+	// up next to it, at parent.relativePath, before the repositories, so it
+	// is the target POM of the input XML.
+	output.NewRule(`Non-resolvable parent POM for \S+ (?:Could not find artifact|Failure to find) (?P<coordinates>\S+) in `+repository, NewCannotResolveTargetPomFromXmlError),
+	// An import POM, a BOM in dependencyManagement, is only ever resolved
+	// from the repositories.
+	output.NewRule(`Non-resolvable import POM: (?:Could not find artifact|Failure to find) (?P<coordinates>\S+) in `+repository, NewCannotResolveTargetPomFromRepoError),
+	// A dependency missing from reachable repositories is an unresolved
+	// dependency, which the description of FailedToBuildMavenProject names.
+	// There is no error for a missing dependency of its own.
+	output.NewRule(`Could not find artifact (?P<coordinates>\S+) in `+repository, NewFailedToBuildMavenProjectError),
+	output.NewRule(`Failed to execute goal|The build could not read \d+ projects?|BUILD FAILURE`, NewFailedToBuildMavenProjectError),
+}
+
+// ParseOutput returns the catalog error matching the output of a Maven
+// command, such as mvn dependency:tree, which exited with exitCode. The detail
+// of the error is the line which matched, without its log level, and its Logs
+// hold the error lines of the output without Maven's help text. Coordinates,
+// property names, repositories and paths named by the matching line are set
+// in Meta, see the MetaKey constants.
+//
+// A run which succeeded, or whose failure is not recognised, returns false.
+func ParseOutput(output string, exitCode int) (snyk_errors.Error, bool) {
+	if exitCode == TimeoutExitCode {
+		logs := errorLines(strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n"))
+		return NewTimeoutWhenProcessingTheDepTreeError(fmt.Sprintf("Maven was stopped after exceeding its time limit (exit code %d)", exitCode), snyk_errors.WithLogs(logs)), true
+	}
+
+	return parser.Parse(output, exitCode)
+}
+
+var parser = output.Parser{
+	Rules:  outputRules,
+	Detail: stripLevel,
+	Logs: func(lines []string, _ int) []string {
+		return errorLines(lines)
+	},
+}
+
+// help starts the lines Maven adds to every failure.
+var help = []string{
+	"To see the full stack trace of the errors",
+	"Re-run Maven using the -X switch",
+	"For more information about the errors and possible solutions",
+	"After correcting the problems, you can resume the build",
+	"[Help ",
+}
+
+// errorLines returns the error and fatal lines of the output, without blank
+// ones and Maven's help text.
+func errorLines(lines []string) []string {
+	var result []string
+
+lines:
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "[ERROR]") && !strings.HasPrefix(line, "[FATAL]") {
+			continue
+		}
+
+		text := stripLevel(line)
+		if text == "" {
+			continue
+		}
+
+		for _, prefix := range help {
+			if strings.HasPrefix(text, prefix) {
+				continue lines
+			}
+		}
+
+		result = append(result, line)
+	}
+
+	return result
+}
+
+// stripLevel removes the log level prefixes of a line, which Maven repeats
+// for nested problems, as in "[ERROR] [ERROR] Some problems were
+// encountered".
+func stripLevel(line string) string {
+	line = strings.TrimSpace(line)
+	for {
+		trimmed := strings.TrimPrefix(strings.TrimPrefix(line, "[ERROR]"), "[FATAL]")
+		if trimmed == line {
+			return line
+		}
+		line = strings.TrimSpace(trimmed)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package maven_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/catalog"
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/internal/output/outputtest"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/maven"
+)
+
+func TestParseOutput_Fixtures(t *testing.T) {
+	fixtures, err := outputtest.LoadFixtures(filepath.Join("testdata", "output"))
+	require.NoError(t, err)
+
+	for _, f := range fixtures {
+		t.Run(f.Name, func(t *testing.T) {
+			require.NoError(t, f.Check(maven.ParseOutput(f.Output, f.ExitCode)))
+		})
+	}
+}
+
+// TestParseOutput_Coverage requires a fixture for every error of the Maven
+// group which Maven itself reports. The others are raised by Snyk while
+// fetching the project, before Maven runs.
+func TestParseOutput_Coverage(t *testing.T) {
+	fixtures, err := outputtest.LoadFixtures(filepath.Join("testdata", "output"))
+	require.NoError(t, err)
+
+	fetcher := map[string]bool{
+		errorcodes.OpenSourceEcosystems.SkippedGroupError:               true,
+		errorcodes.OpenSourceEcosystems.CannotGetBuildFileFromRepoError: true,
+		errorcodes.OpenSourceEcosystems.CannotCreateGitHostError:        true,
+		errorcodes.OpenSourceEcosystems.SourceNotSupportedError:         true,
+	}
+
+	var codes []string
+	for _, entry := range catalog.All() {
+		if entry.Namespace == "OpenSourceEcosystems" && entry.Group == "Maven" && !fetcher[entry.Code] {
+			codes = append(codes, catalog.Canonical(entry.Code))
+		}
+	}
+
+	require.Empty(t, outputtest.Uncovered(fixtures, codes))
+}
+
+func TestParseOutput(t *testing.T) {
+	output := "[INFO] Scanning for projects...\r\n" +
+		"[ERROR] The goal you specified requires a project to execute but there is no POM in this directory (/app). Please verify you invoked Maven from the correct directory. -> [Help 1]\r\n"
+
+	actual, ok := maven.ParseOutput(output, 1)
+	require.True(t, ok)
+	require.Equal(t, "The goal you specified requires a project to execute but there is no POM in this directory (/app). Please verify you invoked Maven from the correct directory. -> [Help 1]", actual.Detail)
+	require.Equal(t, []string{"[ERROR] " + actual.Detail}, actual.Logs)
+	require.Equal(t, "/app", actual.Meta[maven.MetaKeyDirectory])
+
+	_, ok = maven.ParseOutput(output, 0)
+	require.False(t, ok)
+}
@@ -0,0 +1,515 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package nodejs contains errors related to the group NodeJS of the namespace
+// OpenSourceEcosystems of the Error Catalog.
+package nodejs
+
+import (
+  "github.com/snyk/error-catalog-golang-public/snyk_errors"
+  "github.com/google/uuid"
+)
+// NewNoRepoFoundForTheNPMPackageError displays errors with the following description:
+// No repository found for the NPM package.
+func NewNoRepoFoundForTheNPMPackageError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0001",
+    Title:      "No repository found for A NPM package",
+    Description: "No repository found for the NPM package.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0001",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCouldNotParseNPMRegistryURLError displays errors with the following description:
+// Could not parse NPM registry URL.
+func NewCouldNotParseNPMRegistryURLError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0002",
+    Title:      "Could not parse NPM registry URL",
+    Description: "Could not parse NPM registry URL.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0002",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCouldNotFindBrokerURLError displays errors with the following description:
+// Could not find a broker resolved URL.
+func NewCouldNotFindBrokerURLError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0003",
+    Title:      "Could not find a broker resolved URL",
+    Description: "Could not find a broker resolved URL.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0003",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnableToReplaceBrokerURLError displays errors with the following description:
+// Unable to replace all broker urls in lock file.
+func NewUnableToReplaceBrokerURLError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0004",
+    Title:      "Unable to replace broker URL",
+    Description: "Unable to replace all broker urls in lock file.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0004",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewBadNPMVersionError displays errors with the following description:
+// The NPM version is not supported.
+func NewBadNPMVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0005",
+    Title:      "Bad NPM version",
+    Description: "The NPM version is not supported.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0005",
+    Classification: "UNSUPPORTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnknownBlobEncodingOnGithubError displays errors with the following description:
+// Unknown blob encoding on Github.
+func NewUnknownBlobEncodingOnGithubError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0006",
+    Title:      "Unknown blob encoding on Github",
+    Description: "Unknown blob encoding on Github.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0006",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewNoResultsFromForkerProcessesError displays errors with the following description:
+// No result from forked process.
+func NewNoResultsFromForkerProcessesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0007",
+    Title:      "No result from forked process",
+    Description: "No result from forked process.",
+    StatusCode: 500,
+    ErrorCode:  "SNYK-OS-NODEJS-0007",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewChildProcessExecutionError displays errors with the following description:
+// The child process encountered an error during execution.
+func NewChildProcessExecutionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0008",
+    Title:      "Child Process Execution Error",
+    Description: "The child process encountered an error during execution.",
+    StatusCode: 500,
+    ErrorCode:  "SNYK-OS-NODEJS-0008",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewNoValidPackageUpgradesError displays errors with the following description:
+// The system attempted to find valid upgrades for the packages specified in the lock file, but none were available.
+func NewNoValidPackageUpgradesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0009",
+    Title:      "No valid package upgrades",
+    Description: "The system attempted to find valid upgrades for the packages specified in the lock file, but none were available.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0009",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewNoDependencyUpdatesError displays errors with the following description:
+// There are no available updates for the dependencies.
+func NewNoDependencyUpdatesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0010",
+    Title:      "No dependency updates",
+    Description: "There are no available updates for the dependencies.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0010",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewCouldNotParseJSONFileError displays errors with the following description:
+// An error occurred while attempting to parse a JSON file.
+func NewCouldNotParseJSONFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0011",
+    Title:      "Could not parse JSON file",
+    Description: "An error occurred while attempting to parse a JSON file.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0011",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewBase64EncodeError displays errors with the following description:
+// An error occurred while attempting to perform Base64 encoding.
+func NewBase64EncodeError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0012",
+    Title:      "Could not Base64 encode",
+    Description: "An error occurred while attempting to perform Base64 encoding.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0012",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewBase64DecodeError displays errors with the following description:
+// An error occurred while attempting to perform Base64 decoding.
+func NewBase64DecodeError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0013",
+    Title:      "Could not Base64 decode",
+    Description: "An error occurred while attempting to perform Base64 decoding.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0013",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewMissingSupportedFileError displays errors with the following description:
+// Could not find supported file.
+func NewMissingSupportedFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0014",
+    Title:      "Missing supported file",
+    Description: "Could not find supported file.",
+    StatusCode: 400,
+    ErrorCode:  "SNYK-OS-NODEJS-0014",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewInvalidConfigurationError displays errors with the following description:
+// The configuration parameter does not meet the expected data type. Please ensure the provided value is of the correct data type.
+func NewInvalidConfigurationError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0015",
+    Title:      "Invalid configuration",
+    Description: "The configuration parameter does not meet the expected data type. Please ensure the provided value is of the correct data type.",
+    StatusCode: 400,
+    ErrorCode:  "SNYK-OS-NODEJS-0015",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "warn",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPnpmOutOfSyncError displays errors with the following description:
+// Sometimes a project may become out of sync between the lockfile and the manifest file. This might happen if the package.json is modified or updated but the pnpm-lock.yaml is not. 
+// 
+// This can be resolved by ensuring the lockfile and manifest file are correctly synced, by executing pnpm install.
+// 
+// In some cases, it may be necessary to delete the node_modules folder and the pnpm-lock.yaml and run pnpm install again to force a full reinstall. 
+//
+// Read more:
+// - https://support.snyk.io/s/article/Out-of-sync-manifest--lockfile-in-the-project
+func NewPnpmOutOfSyncError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0016",
+    Title:      "Out of Sync Error",
+    Description: "Sometimes a project may become out of sync between the lockfile and the manifest file. This might happen if the package.json is modified or updated but the pnpm-lock.yaml is not. \n\nThis can be resolved by ensuring the lockfile and manifest file are correctly synced, by executing pnpm install.\n\nIn some cases, it may be necessary to delete the node_modules folder and the pnpm-lock.yaml and run pnpm install again to force a full reinstall. ",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0016",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://support.snyk.io/s/article/Out-of-sync-manifest--lockfile-in-the-project",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPnpmUnsupportedLockfileVersionError displays errors with the following description:
+// The lockfile version is not supported. Supported lockfile versions for pnpm include v5 and v6.
+func NewPnpmUnsupportedLockfileVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0017",
+    Title:      "Unsupported pnpm lockfile version",
+    Description: "The lockfile version is not supported. Supported lockfile versions for pnpm include v5 and v6.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-NODEJS-0017",
+    Classification: "UNSUPPORTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewYarnPackageNotFoundError displays errors with the following description:
+// Snyk could not find the package in the Yarn registry.
+func NewYarnPackageNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0019",
+    Title:      "Yarn package not found",
+    Description: "Snyk could not find the package in the Yarn registry.",
+    StatusCode: 404,
+    ErrorCode:  "SNYK-OS-NODEJS-0019",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewUnableToReachRegistryError displays errors with the following description:
+// Snyk could not reach the node package registry.
+func NewUnableToReachRegistryError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0020",
+    Title:      "Unable to reach package registry",
+    Description: "Snyk could not reach the node package registry.",
+    StatusCode: 503,
+    ErrorCode:  "SNYK-OS-NODEJS-0020",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewOutdatedYarnLockFileError displays errors with the following description:
+// The lock file is outdated. Update the lock file and try again.
+func NewOutdatedYarnLockFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0021",
+    Title:      "Lock file is outdated",
+    Description: "The lock file is outdated. Update the lock file and try again.",
+    StatusCode: 409,
+    ErrorCode:  "SNYK-OS-NODEJS-0021",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPermissionDeniedError displays errors with the following description:
+// Snyk does not have sufficient permissions to access the repository, or the repository does not exist.
+func NewPermissionDeniedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-nodejs-0022",
+    Title:      "Unable to read from remote repository",
+    Description: "Snyk does not have sufficient permissions to access the repository, or the repository does not exist.",
+    StatusCode: 401,
+    ErrorCode:  "SNYK-OS-NODEJS-0022",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
@@ -0,0 +1,367 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package python contains errors related to the group Python of the namespace
+// OpenSourceEcosystems of the Error Catalog.
+package python
+
+import (
+  "github.com/snyk/error-catalog-golang-public/snyk_errors"
+  "github.com/google/uuid"
+)
+// NewUnsupportedRequirementsFileError displays errors with the following description:
+// The provided requirements file is not supported by Snyk for Python.
+//
+// Read more:
+// - https://docs.snyk.io/scan-applications/supported-languages-and-frameworks/python
+func NewUnsupportedRequirementsFileError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0001",
+    Title:      "Unsupported manifest file type for remediation",
+    Description: "The provided requirements file is not supported by Snyk for Python.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0001",
+    Classification: "UNSUPPORTED",
+    Links: []string{
+      "https://docs.snyk.io/scan-applications/supported-languages-and-frameworks/python",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewTooManyManifestFilesError displays errors with the following description:
+// Too many manifest files were provided in the request body.
+func NewTooManyManifestFilesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0002",
+    Title:      "Received more manifests than expected",
+    Description: "Too many manifest files were provided in the request body.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0002",
+    Classification: "UNSUPPORTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewFailedToApplyDependencyUpdatesError displays errors with the following description:
+// An error occurred while updating dependencies.
+func NewFailedToApplyDependencyUpdatesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0003",
+    Title:      "Failed to apply dependency updates",
+    Description: "An error occurred while updating dependencies.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0003",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPythonPackageNotFoundError displays errors with the following description:
+// A package listed in the manifest file cannot be found in the Python Package Index(PyPI).
+// Make sure all packages included in the manifest file are public existing ones.
+func NewPythonPackageNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0004",
+    Title:      "Python package not found",
+    Description: "A package listed in the manifest file cannot be found in the Python Package Index(PyPI).\nMake sure all packages included in the manifest file are public existing ones.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0004",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewSyntaxIssuesError displays errors with the following description:
+// The manifest file has syntax issues like incorrect package names or unsupported characters.
+// Make sure the manifest file follows the syntax stardards and can be installed locally as well.
+func NewSyntaxIssuesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0005",
+    Title:      "Syntax errors found in manifest file",
+    Description: "The manifest file has syntax issues like incorrect package names or unsupported characters.\nMake sure the manifest file follows the syntax stardards and can be installed locally as well.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0005",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPipUnsupportedPythonVersionError displays errors with the following description:
+// At least one of the packages requires a Python version that doesn't match the one used in the project scan.
+// Make sure to select a suitable Python version from the organization Python language settings.
+// Alternatively, add a `.snyk` file for Python version selection override.
+func NewPipUnsupportedPythonVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0006",
+    Title:      "Python version not supported",
+    Description: "At least one of the packages requires a Python version that doesn't match the one used in the project scan.\nMake sure to select a suitable Python version from the organization Python language settings.\nAlternatively, add a `.snyk` file for Python version selection override.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0006",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPythonVersionConfictError displays errors with the following description:
+// Two or more packages have conflicting version requirements that cannot be resolved.
+// Make sure no two packages and their requirements cause conflicts and that the manifest file can be installed locally.
+func NewPythonVersionConfictError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0007",
+    Title:      "Packages versions caused conflicts",
+    Description: "Two or more packages have conflicting version requirements that cannot be resolved.\nMake sure no two packages and their requirements cause conflicts and that the manifest file can be installed locally.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0007",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPipNoMatchingPythonDistributionError displays errors with the following description:
+// At least one of the packages requires a Python version that doesn't match the one used in the project scan.
+// Make sure to select a suitable Python version from the organization Python language settings.
+// Alternatively, add a `.snyk` file for Python version selection override.
+func NewPipNoMatchingPythonDistributionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0008",
+    Title:      "No matching distribution found for one or more of the packages",
+    Description: "At least one of the packages requires a Python version that doesn't match the one used in the project scan.\nMake sure to select a suitable Python version from the organization Python language settings.\nAlternatively, add a `.snyk` file for Python version selection override.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0008",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewInstallationFailureError displays errors with the following description:
+// Some packages failed during installation due to missing system dependencies, compilation errors, or other package-specific issues.
+func NewInstallationFailureError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0009",
+    Title:      "Packages installation failed",
+    Description: "Some packages failed during installation due to missing system dependencies, compilation errors, or other package-specific issues.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0009",
+    Classification: "UNSUPPORTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPipenvUnsupportedPythonVersionError displays errors with the following description:
+// At least one of the packages requires a Python version that doesn't match the one used in the project scan.
+// Make sure to use the correct python version in the requires section of the Pipfile.
+func NewPipenvUnsupportedPythonVersionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0010",
+    Title:      "Python version not supported",
+    Description: "At least one of the packages requires a Python version that doesn't match the one used in the project scan.\nMake sure to use the correct python version in the requires section of the Pipfile.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0010",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPipenvNoMatchingPythonDistributionError displays errors with the following description:
+// At least one of the packages requires a Python version that doesn't match the one used in the project scan.
+// Make sure to use the correct python version in the requires section of the Pipfile.
+func NewPipenvNoMatchingPythonDistributionError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0011",
+    Title:      "No matching distribution found for one or more of the packages",
+    Description: "At least one of the packages requires a Python version that doesn't match the one used in the project scan.\nMake sure to use the correct python version in the requires section of the Pipfile.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0011",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPythonDependenciesSpaceLimitExceededError displays errors with the following description:
+// The total size of the downloaded Python dependencies in the manifest file exceeds the 10GB limit.
+// This often happens due to the large size of some of the Python dependencies and is usually the case for Python packages that require NVIDIA drivers like PyTorch. 
+//
+// Read more:
+// - https://docs.snyk.io/supported-languages-package-managers-and-frameworks/python/git-repositories-and-python#pip-and-git-repositories
+func NewPythonDependenciesSpaceLimitExceededError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0012",
+    Title:      "The 10 GB space limit for downloaded Python dependencies has been exceeded",
+    Description: "The total size of the downloaded Python dependencies in the manifest file exceeds the 10GB limit.\nThis often happens due to the large size of some of the Python dependencies and is usually the case for Python packages that require NVIDIA drivers like PyTorch. ",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0012",
+    Classification: "UNSUPPORTED",
+    Links: []string{
+      "https://docs.snyk.io/supported-languages-package-managers-and-frameworks/python/git-repositories-and-python#pip-and-git-repositories",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPythonRequiredPackagesMissingError displays errors with the following description:
+// One or more required Python packages could not be resolved or were missing during dependency analysis.
+// Verify that all required dependencies are declared in your manifest file and that they can be installed successfully using your package manager.
+func NewPythonRequiredPackagesMissingError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0013",
+    Title:      "Missing required packages",
+    Description: "One or more required Python packages could not be resolved or were missing during dependency analysis.\nVerify that all required dependencies are declared in your manifest file and that they can be installed successfully using your package manager.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-PYTHON-0013",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewPythonFailedToWriteTempFilesError displays errors with the following description:
+// The plugin failed to create or write temporary files required during dependency analysis.
+// Ensure the process has permission to write to the system temporary directory and that sufficient disk space is available.
+func NewPythonFailedToWriteTempFilesError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-python-0014",
+    Title:      "Failed to write temp files",
+    Description: "The plugin failed to create or write temporary files required during dependency analysis.\nEnsure the process has permission to write to the system temporary directory and that sufficient disk space is available.",
+    StatusCode: 500,
+    ErrorCode:  "SNYK-OS-PYTHON-0014",
+    Classification: "UNEXPECTED",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
@@ -0,0 +1,96 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ruby contains errors related to the group Ruby of the namespace
+// OpenSourceEcosystems of the Error Catalog.
+package ruby
+
+import (
+  "github.com/snyk/error-catalog-golang-public/snyk_errors"
+  "github.com/google/uuid"
+)
+// NewCyclicDependencyDetectedError displays errors with the following description:
+// Cyclic dependency detected in lockfile.
+func NewCyclicDependencyDetectedError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-ruby-0001",
+    Title:      "Cyclic dependency detected in lockfile",
+    Description: "Cyclic dependency detected in lockfile.",
+    StatusCode: 422,
+    ErrorCode:  "SNYK-OS-RUBY-0001",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGemNotFoundError displays errors with the following description:
+// A gem listed in the Gemfile cannot be found in the RubyGems repository or locally.
+// Make sure all gems included in the Gemfile are publicly available or properly configured in your gem sources.
+// Verify that the gem name and version are correct, and check that you have access to any private gem repositories.
+func NewGemNotFoundError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-ruby-0002",
+    Title:      "Gem not found",
+    Description: "A gem listed in the Gemfile cannot be found in the RubyGems repository or locally.\nMake sure all gems included in the Gemfile are publicly available or properly configured in your gem sources.\nVerify that the gem name and version are correct, and check that you have access to any private gem repositories.",
+    StatusCode: 404,
+    ErrorCode:  "SNYK-OS-RUBY-0002",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
+
+// NewGemVersionConflictError displays errors with the following description:
+// Bundler was unable to resolve compatible versions for the gems specified in the Gemfile.
+// This occurs when multiple gems have conflicting version requirements that cannot be satisfied simultaneously.
+// Review your Gemfile and Gemfile.lock to identify conflicting dependencies, and consider updating gem versions or constraints to resolve the conflict.
+func NewGemVersionConflictError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-ruby-0003",
+    Title:      "Gem version conflict",
+    Description: "Bundler was unable to resolve compatible versions for the gems specified in the Gemfile.\nThis occurs when multiple gems have conflicting version requirements that cannot be satisfied simultaneously.\nReview your Gemfile and Gemfile.lock to identify conflicting dependencies, and consider updating gem versions or constraints to resolve the conflict.",
+    StatusCode: 409,
+    ErrorCode:  "SNYK-OS-RUBY-0003",
+    Classification: "ACTIONABLE",
+    Links: []string{},
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
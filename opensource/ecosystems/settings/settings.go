@@ -0,0 +1,51 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package settings contains errors related to the group Settings of the namespace
+// OpenSourceEcosystems of the Error Catalog.
+package settings
+
+import (
+  "github.com/snyk/error-catalog-golang-public/snyk_errors"
+  "github.com/google/uuid"
+)
+// NewReachabilitySettingDisabledError displays errors with the following description:
+// The reachability settings are not enabled for your Organization. You can enable them from the Setttings page or you can switch to an Organization where the reachability settings are already enabled.
+//
+// Read more:
+// - https://docs.snyk.io/manage-risk/prioritize-issues-for-fixing/reachability-analysis
+func NewReachabilitySettingDisabledError(detail string, options ...snyk_errors.Option) snyk_errors.Error {
+  err := snyk_errors.Error{
+    ID:         uuid.NewString(),
+    Type:       "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-os-settings-0001",
+    Title:      "Reachability settings not enabled",
+    Description: "The reachability settings are not enabled for your Organization. You can enable them from the Setttings page or you can switch to an Organization where the reachability settings are already enabled.",
+    StatusCode: 403,
+    ErrorCode:  "SNYK-OS-SETTINGS-0001",
+    Classification: "ACTIONABLE",
+    Links: []string{
+      "https://docs.snyk.io/manage-risk/prioritize-issues-for-fixing/reachability-analysis",
+    },
+    Level:  "error",
+    Detail: detail,
+  }
+
+  for _, option := range options {
+    option(&err)
+  }
+
+  return err
+}
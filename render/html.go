@@ -0,0 +1,105 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package render
+
+import (
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+var htmlTemplate = template.Must(template.New("error").Parse(`<div class="snyk-error" data-level="{{.Level}}" data-classification="{{.Classification}}">
+<p><strong>{{.Title}}</strong>{{if .Code}} ({{if .Type}}<a href="{{.Type}}">{{.Code}}</a>{{else}}{{.Code}}{{end}}){{end}}</p>
+{{- range .Detail}}
+<p>{{.}}</p>
+{{- end}}
+{{- if .Description}}
+<blockquote>
+{{- range .Description}}
+<p>{{.}}</p>
+{{- end}}
+</blockquote>
+{{- end}}
+{{- if .Links}}
+<p>Read more:</p>
+<ul>
+{{- range .Links}}
+<li><a href="{{.}}">{{.}}</a></li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .Logs}}
+<details>
+<summary>Logs</summary>
+<pre><code>{{.Logs}}</code></pre>
+</details>
+{{- end}}
+</div>
+`))
+
+type htmlError struct {
+	Title          string
+	Code           string
+	Type           string
+	Level          string
+	Classification string
+	Detail         []string
+	Description    []string
+	Links          []string
+	Logs           string
+}
+
+// HTML writes err to w as a sanitised HTML fragment, suitable for reports.
+// All text taken from the error is escaped and only http and https links are
+// emitted.
+func HTML(w io.Writer, err snyk_errors.Error) error {
+	data := htmlError{
+		Title:          err.Title,
+		Code:           err.ErrorCode,
+		Level:          err.Level,
+		Classification: err.Classification,
+		Detail:         paragraphs(err.Detail),
+		Description:    paragraphs(err.Description),
+		Logs:           strings.Join(err.Logs, "\n"),
+	}
+
+	if link, ok := safeURL(err.Type); ok {
+		data.Type = link
+	}
+
+	for _, link := range err.Links {
+		if link, ok := safeURL(link); ok {
+			data.Links = append(data.Links, link)
+		}
+	}
+
+	return htmlTemplate.Execute(w, data)
+}
+
+// paragraphs splits s on blank lines.
+func paragraphs(s string) []string {
+	var result []string
+
+	for _, p := range strings.Split(s, "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,73 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/fix"
+	"github.com/snyk/error-catalog-golang-public/prchecks"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestHTML(t *testing.T) {
+	type test struct {
+		description string
+		golden      string
+		error       snyk_errors.Error
+	}
+
+	tests := []test{
+		{
+			description: "pull request check error with logs",
+			golden:      "html_prchecks",
+			error: prchecks.NewFailedToReadManifestError("package.json could not be read",
+				snyk_errors.WithLogs([]string{"reading package.json", "</code></pre><b>unexpected EOF</b>"})),
+		},
+		{
+			description: "fix error with links",
+			golden:      "html_fix",
+			error:       fix.NewFixScenarioNotSupportedError("Upgrading lodash is not supported"),
+		},
+		{
+			description: "injected detail",
+			golden:      "html_injection",
+			error: prchecks.NewManifestNotFoundError(injectedDetail,
+				snyk_errors.WithLinks([]string{"javascript:alert(1)"})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, HTML(&buf, tt.error))
+
+			requireGolden(t, tt.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestHTMLEscapesDetail(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, HTML(&buf, snyk_errors.Error{Title: "title", Detail: injectedDetail, Type: "javascript:alert(1)", ErrorCode: "SNYK-0001"}))
+
+	require.Contains(t, buf.String(), "&lt;script&gt;")
+	require.Contains(t, buf.String(), "&lt;img")
+	require.NotContains(t, buf.String(), `href="javascript`)
+}
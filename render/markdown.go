@@ -0,0 +1,113 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package render
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/internal/markdown"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// Markdown writes err to w as GitHub-flavoured Markdown, suitable for pull
+// request comments. The detail is escaped, so neither Markdown nor inline HTML
+// supplied by users is interpreted. The catalog description keeps its lists
+// and paragraphs.
+func Markdown(w io.Writer, err snyk_errors.Error) error {
+	var sb strings.Builder
+
+	sb.WriteString("**" + markdown.Escape(err.Title) + "**")
+	if err.ErrorCode != "" {
+		code := markdown.Escape(err.ErrorCode)
+		if link, ok := safeURL(err.Type); ok {
+			code = fmt.Sprintf("[%s](<%s>)", code, link)
+		}
+		sb.WriteString(" (" + code + ")")
+	}
+	sb.WriteString("\n")
+
+	if err.Detail != "" {
+		sb.WriteString("\n" + markdown.EscapeLines(err.Detail, markdown.Escape, true) + "\n")
+	}
+
+	if err.Description != "" {
+		sb.WriteString("\n> " + strings.ReplaceAll(markdown.EscapeLines(err.Description, markdown.EscapeInline, false), "\n", "\n> ") + "\n")
+	}
+
+	var links []string
+	for _, link := range err.Links {
+		if link, ok := safeURL(link); ok {
+			links = append(links, link)
+		}
+	}
+	if len(links) > 0 {
+		sb.WriteString("\n**Read more:**\n")
+		for _, link := range links {
+			sb.WriteString("- <" + link + ">\n")
+		}
+	}
+
+	if len(err.Logs) > 0 {
+		logs := strings.Join(err.Logs, "\n")
+		fence := "```"
+		if n := longestRun(logs, '`'); n >= len(fence) {
+			fence = strings.Repeat("`", n+1)
+		}
+
+		sb.WriteString("\n<details>\n<summary>Logs</summary>\n\n")
+		sb.WriteString(fence + "text\n" + logs + "\n" + fence + "\n")
+		sb.WriteString("\n</details>\n")
+	}
+
+	_, writeErr := io.WriteString(w, sb.String())
+	return writeErr
+}
+
+// safeURL returns link if it is an absolute http or https URL which can be
+// embedded in Markdown and HTML without further escaping.
+func safeURL(link string) (string, bool) {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+
+	link = u.String()
+	if strings.ContainsAny(link, "<>\"' \t\n") {
+		return "", false
+	}
+
+	return link, true
+}
+
+func longestRun(s string, c rune) int {
+	var longest, current int
+
+	for _, r := range s {
+		if r != c {
+			current = 0
+			continue
+		}
+		current++
+		if current > longest {
+			longest = current
+		}
+	}
+
+	return longest
+}
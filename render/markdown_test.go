@@ -0,0 +1,100 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/fix"
+	"github.com/snyk/error-catalog-golang-public/prchecks"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+const injectedDetail = "Manifest <script>alert(1)</script> has [a link](javascript:alert(1)) and **bold**\n\n</details><img src=x onerror=alert(1)>"
+
+func TestMarkdown(t *testing.T) {
+	type test struct {
+		description string
+		golden      string
+		error       snyk_errors.Error
+	}
+
+	tests := []test{
+		{
+			description: "pull request check error with logs",
+			golden:      "markdown_prchecks",
+			error: prchecks.NewFailedToReadManifestError("package.json could not be read",
+				snyk_errors.WithLogs([]string{"reading package.json", "```unexpected EOF```"})),
+		},
+		{
+			description: "fix error with links",
+			golden:      "markdown_fix",
+			error:       fix.NewFixScenarioNotSupportedError("Upgrading lodash is not supported"),
+		},
+		{
+			description: "injected detail",
+			golden:      "markdown_injection",
+			error: prchecks.NewManifestNotFoundError(injectedDetail,
+				snyk_errors.WithLinks([]string{"javascript:alert(1)"})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Markdown(&buf, tt.error))
+
+			requireGolden(t, tt.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestMarkdownEscapesDetail(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Markdown(&buf, snyk_errors.Error{Title: "title", Detail: injectedDetail}))
+
+	require.Contains(t, buf.String(), `\<script\>`)
+	require.Contains(t, buf.String(), `\<img`)
+	require.Contains(t, buf.String(), `\](javascript`)
+	require.NotRegexp(t, `[^\\][<\[]`, buf.String())
+}
+
+func TestMarkdownNeutralisesMentions(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Markdown(&buf, snyk_errors.Error{
+		Title:       "Ping @security-team",
+		Detail:      "Reported by @octocat in #123, see acme/app#45.",
+		Description: "Mail support@snyk.io or use the # key in #general.",
+	}))
+
+	require.NotRegexp(t, `(^|[^\pL\pN])@[\pL\pN]|#\pN`, buf.String())
+	require.Contains(t, buf.String(), "@\u200dsecurity-team")
+	require.Contains(t, buf.String(), "@\u200doctocat")
+	require.Contains(t, buf.String(), "#\u200d123")
+	require.Contains(t, buf.String(), "acme/app#\u200d45")
+	require.Contains(t, buf.String(), "support@snyk.io")
+	require.Contains(t, buf.String(), "the # key in #general")
+}
+
+func TestMarkdownIgnoresUnsafeType(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Markdown(&buf, snyk_errors.Error{Title: "title", ErrorCode: "SNYK-0001", Type: "javascript:alert(1)"}))
+
+	require.Equal(t, "**title** (SNYK-0001)\n", buf.String())
+}
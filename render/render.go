@@ -0,0 +1,349 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package render prints Error Catalog errors in a human-friendly format
+// suitable for terminals.
+//
+// Example:
+//
+//	r := render.New(render.WithWidth(80), render.WithColor(render.ColorEnabled(os.Stdout)))
+//	_ = r.Render(os.Stderr, err)
+package render
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// DefaultWidth is the total width of a rendered box, including its borders,
+// used when no width has been configured.
+const DefaultWidth = 80
+
+// minWidth is the smallest width a box can be rendered with.
+const minWidth = 20
+
+const (
+	ansiReset   = "\x1b[0m"
+	ansiBold    = "\x1b[1m"
+	ansiDim     = "\x1b[2m"
+	ansiRed     = "\x1b[31m"
+	ansiYellow  = "\x1b[33m"
+	ansiBlue    = "\x1b[34m"
+	ansiMagenta = "\x1b[35m"
+)
+
+// Renderer formats errors as boxed blocks for terminal output.
+type Renderer struct {
+	width int
+	color bool
+}
+
+type Option func(r *Renderer)
+
+// WithWidth sets the total width of the rendered box. Widths below a sensible
+// minimum are raised to it.
+func WithWidth(width int) Option {
+	return func(r *Renderer) {
+		r.width = width
+	}
+}
+
+// WithColor enables or disables ANSI colour sequences in the output.
+func WithColor(enabled bool) Option {
+	return func(r *Renderer) {
+		r.color = enabled
+	}
+}
+
+// New creates a Renderer. Without options it renders DefaultWidth wide boxes
+// without colour.
+func New(options ...Option) *Renderer {
+	r := &Renderer{
+		width: DefaultWidth,
+	}
+
+	for _, option := range options {
+		option(r)
+	}
+
+	if r.width < minWidth {
+		r.width = minWidth
+	}
+
+	return r
+}
+
+// ColorEnabled reports whether colour output should be used for the given
+// file. Colour is disabled when the NO_COLOR environment variable is set or
+// when the file is not a terminal.
+func ColorEnabled(f *os.File) bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+
+	if f == nil {
+		return false
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Render writes err to w as a boxed block.
+func (r *Renderer) Render(w io.Writer, err snyk_errors.Error) error {
+	_, writeErr := io.WriteString(w, r.box(err))
+	return writeErr
+}
+
+// RenderAll writes every error in errs followed by a one line summary
+// counting the errors per level.
+func (r *Renderer) RenderAll(w io.Writer, errs []snyk_errors.Error) error {
+	var sb strings.Builder
+
+	for i, err := range errs {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(r.box(err))
+	}
+
+	sb.WriteString(r.summary(errs))
+
+	_, writeErr := io.WriteString(w, sb.String())
+	return writeErr
+}
+
+func (r *Renderer) box(err snyk_errors.Error) string {
+	inner := r.width - 4
+	color := levelColor(err.Level)
+
+	var body []string
+
+	title := sanitize(err.Title)
+	if err.ErrorCode != "" {
+		title = fmt.Sprintf("%s (%s)", title, sanitize(err.ErrorCode))
+	}
+	for _, line := range wrap(title, inner) {
+		body = append(body, r.paint(ansiBold, pad(line, inner)))
+	}
+
+	if err.Detail != "" {
+		body = append(body, pad("", inner))
+		for _, line := range wrap(sanitize(err.Detail), inner) {
+			body = append(body, pad(line, inner))
+		}
+	}
+
+	if err.Description != "" {
+		body = append(body, pad("", inner))
+		for _, line := range wrap(sanitize(err.Description), inner) {
+			body = append(body, r.paint(ansiDim, pad(line, inner)))
+		}
+	}
+
+	if links := readMore(err); len(links) > 0 {
+		body = append(body, pad("", inner), pad("Read more:", inner))
+		for i, link := range links {
+			prefix := fmt.Sprintf(" %d. ", i+1)
+			for j, line := range wrap(sanitize(link), inner-len(prefix)) {
+				if j > 0 {
+					prefix = strings.Repeat(" ", len(prefix))
+				}
+				body = append(body, prefix+r.paint(ansiBlue, pad(line, inner-len(prefix))))
+			}
+		}
+	}
+
+	var sb strings.Builder
+
+	label := " " + sanitize(headerLabel(err)) + " "
+	if displayWidth(label) > r.width-3 {
+		label = ""
+	}
+	sb.WriteString(r.paint(color, "┌─"+label+strings.Repeat("─", r.width-3-displayWidth(label))+"┐"))
+	sb.WriteString("\n")
+
+	for _, line := range body {
+		sb.WriteString(r.paint(color, "│"))
+		sb.WriteString(" " + line + " ")
+		sb.WriteString(r.paint(color, "│"))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(r.paint(color, "└"+strings.Repeat("─", r.width-2)+"┘"))
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+func (r *Renderer) summary(errs []snyk_errors.Error) string {
+	if len(errs) == 0 {
+		return "No errors.\n"
+	}
+
+	var (
+		order  []string
+		counts = make(map[string]int)
+	)
+	for _, err := range errs {
+		level := levelName(err.Level)
+		if counts[level] == 0 {
+			order = append(order, level)
+		}
+		counts[level]++
+	}
+
+	parts := make([]string, 0, len(order))
+	for _, level := range order {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[level], strings.ToLower(level)))
+	}
+
+	noun := "errors"
+	if len(errs) == 1 {
+		noun = "error"
+	}
+
+	return fmt.Sprintf("\n%s (%s)\n", r.paint(ansiBold, fmt.Sprintf("%d %s", len(errs), noun)), strings.Join(parts, ", "))
+}
+
+func (r *Renderer) paint(code, s string) string {
+	if !r.color || code == "" {
+		return s
+	}
+
+	return code + s + ansiReset
+}
+
+func headerLabel(err snyk_errors.Error) string {
+	label := levelName(err.Level)
+	if err.Classification != "" {
+		label += " · " + err.Classification
+	}
+
+	return label
+}
+
+func levelName(level string) string {
+	if level == "" {
+		return "ERROR"
+	}
+
+	return strings.ToUpper(level)
+}
+
+func levelColor(level string) string {
+	switch level {
+	case "fatal":
+		return ansiMagenta
+	case "warn":
+		return ansiYellow
+	case "error", "":
+		return ansiRed
+	default:
+		return ansiBlue
+	}
+}
+
+// readMore returns the catalog documentation followed by the additional links
+// of err, without duplicates.
+func readMore(err snyk_errors.Error) []string {
+	var links []string
+	seen := make(map[string]bool)
+
+	for _, link := range append([]string{err.Type}, err.Links...) {
+		if link == "" || seen[link] {
+			continue
+		}
+		seen[link] = true
+		links = append(links, link)
+	}
+
+	return links
+}
+
+// wrap breaks text into lines of at most width terminal columns, see
+// displayWidth. Existing line breaks are kept and words longer than width are
+// split.
+func wrap(text string, width int) []string {
+	var lines []string
+
+	for _, paragraph := range strings.Split(text, "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		var line string
+		for _, word := range words {
+			for displayWidth(word) > width {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				head, tail := cut(word, width)
+				lines = append(lines, head)
+				word = tail
+			}
+
+			switch {
+			case line == "":
+				line = word
+			case displayWidth(line)+1+displayWidth(word) <= width:
+				line += " " + word
+			default:
+				lines = append(lines, line)
+				line = word
+			}
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// cut splits s after the runes which fit into width columns, keeping at least
+// one rune in head so that wrapping always progresses.
+func cut(s string, width int) (head, tail string) {
+	columns := 0
+	for i, r := range s {
+		columns += runeWidth(r)
+		if columns > width && i > 0 {
+			return s[:i], s[i:]
+		}
+	}
+
+	return s, ""
+}
+
+func pad(s string, width int) string {
+	if n := displayWidth(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+
+	return s
+}
@@ -0,0 +1,192 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package render
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/cli"
+	"github.com/snyk/error-catalog-golang-public/code"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func requireGolden(t *testing.T, name string, actual []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		require.NoError(t, os.MkdirAll("testdata", 0o755))
+		require.NoError(t, os.WriteFile(path, actual, 0o644))
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual))
+}
+
+func TestRender(t *testing.T) {
+	type test struct {
+		description string
+		golden      string
+		error       snyk_errors.Error
+		options     []Option
+	}
+
+	tests := []test{
+		{
+			description: "fatal level with colour",
+			golden:      "level_fatal",
+			error:       cli.NewInvalidFlagOptionError("The value 'extreme' is not valid for --severity-threshold."),
+			options:     []Option{WithColor(true)},
+		},
+		{
+			description: "error level with colour",
+			golden:      "level_error",
+			error:       ecosystems.NewUnparseableManifestError("Unexpected token in package.json at line 3."),
+			options:     []Option{WithColor(true)},
+		},
+		{
+			description: "warn level with colour",
+			golden:      "level_warn",
+			error:       snyk.NewTooManyRequestsError("Retry after 60 seconds."),
+			options:     []Option{WithColor(true)},
+		},
+		{
+			description: "unknown level with colour",
+			golden:      "level_unknown",
+			error: snyk_errors.Error{
+				Title:     "Something happened",
+				ErrorCode: "SNYK-TEST-0001",
+				Level:     "info",
+			},
+			options: []Option{WithColor(true)},
+		},
+		{
+			description: "actionable classification",
+			golden:      "classification_actionable",
+			error:       code.NewFeatureIsNotEnabledError("Snyk Code is not enabled for the organization."),
+		},
+		{
+			description: "unexpected classification",
+			golden:      "classification_unexpected",
+			error:       snyk.NewServerError("", snyk_errors.WithLinks([]string{"https://status.snyk.io/"})),
+		},
+		{
+			description: "unsupported classification",
+			golden:      "classification_unsupported",
+			error:       snyk.NewNotImplementedError("The method PATCH is not supported."),
+		},
+		{
+			description: "narrow width wraps long words",
+			golden:      "narrow",
+			error:       ecosystems.NewUnknownDependencyVersionError("Could not resolve lodash@^4."),
+			options:     []Option{WithWidth(40)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, New(tt.options...).Render(&buf, tt.error))
+
+			requireGolden(t, tt.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestRenderAll(t *testing.T) {
+	errs := []snyk_errors.Error{
+		ecosystems.NewUnparseableLockFileError("package-lock.json"),
+		snyk.NewTooManyRequestsError(""),
+		ecosystems.NewGoModFileMissingError("services/api"),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, New(WithWidth(60)).RenderAll(&buf, errs))
+
+	requireGolden(t, "summary", buf.Bytes())
+}
+
+func TestRenderAllEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, New().RenderAll(&buf, nil))
+
+	require.Equal(t, "No errors.\n", buf.String())
+}
+
+func TestColorEnabled(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.False(t, ColorEnabled(f), "regular files are not terminals")
+
+	t.Setenv("NO_COLOR", "1")
+	require.False(t, ColorEnabled(os.Stdout))
+}
+
+func TestWrap(t *testing.T) {
+	require.Equal(t, []string{"aaa bb", "cc"}, wrap("aaa bb cc", 6))
+	require.Equal(t, []string{"abcdef", "gh"}, wrap("abcdefgh", 6))
+	require.Equal(t, []string{"a", "", "b"}, wrap("a\n\nb", 6))
+	require.Equal(t, []string{"日本語", "の説明"}, wrap("日本語の説明", 6))
+	require.Equal(t, []string{"ab日本", "語"}, wrap("ab日本語", 7))
+}
+
+// TestRenderWideCharacters requires every line of a box holding East Asian
+// wide characters, such as the messages of the ja bundle, to take the width
+// of the box in terminal columns.
+func TestRenderWideCharacters(t *testing.T) {
+	err := ecosystems.NewUnparseableManifestError("マニフェストファイル package.json の 12 行目に予期しないトークンがあります。構文を確認してください。")
+	err.Title = "マニフェストファイルを解析できません"
+
+	var buf bytes.Buffer
+	require.NoError(t, New(WithWidth(40)).Render(&buf, err))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Greater(t, len(lines), 4)
+	for _, line := range lines {
+		require.Equal(t, 40, displayWidth(line), line)
+	}
+	require.Contains(t, buf.String(), "マニフェストファイル")
+}
+
+func TestSanitize(t *testing.T) {
+	require.Equal(t, "red text moved", sanitize("\x1b[31mred\x1b[0m text\x1b[2J moved"))
+	require.Equal(t, "link", sanitize("\x1b]8;;https://evil.example\x07link\x1b]8;;\x07"))
+	require.Equal(t, "a b\nc", sanitize("a\tb\r\nc\x00\x07"))
+}
+
+func TestRenderSanitizesDetail(t *testing.T) {
+	err := ecosystems.NewUnparseableManifestError("\x1b[2J\x1b[Hcleared\rscreen")
+
+	var buf bytes.Buffer
+	require.NoError(t, New().Render(&buf, err))
+	require.NotContains(t, buf.String(), "\x1b")
+	require.NotContains(t, buf.String(), "\r")
+	require.Contains(t, buf.String(), "clearedscreen")
+}
@@ -0,0 +1,96 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package render
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// wideRanges are the ranges of East Asian Wide and Fullwidth characters, see
+// Unicode Standard Annex #11, and of emoji, which terminals draw two columns
+// wide.
+var wideRanges = []struct{ lo, hi rune }{
+	{0x1100, 0x115F},   // Hangul Jamo initial consonants
+	{0x2E80, 0x303E},   // CJK radicals, Kangxi radicals, CJK symbols and punctuation
+	{0x3041, 0x33FF},   // Hiragana, Katakana, Bopomofo, Hangul compatibility Jamo, CJK compatibility
+	{0x3400, 0x4DBF},   // CJK unified ideographs extension A
+	{0x4E00, 0x9FFF},   // CJK unified ideographs
+	{0xA000, 0xA4CF},   // Yi syllables and radicals
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE30, 0xFE4F},   // CJK compatibility forms
+	{0xFF00, 0xFF60},   // Fullwidth forms
+	{0xFFE0, 0xFFE6},   // Fullwidth signs
+	{0x1F300, 0x1F64F}, // Miscellaneous symbols and pictographs, emoticons
+	{0x1F900, 0x1F9FF}, // Supplemental symbols and pictographs
+	{0x20000, 0x2FFFD}, // CJK unified ideographs extensions B to F
+	{0x30000, 0x3FFFD}, // CJK unified ideographs extension G
+}
+
+// runeWidth returns the number of terminal columns r takes: none for
+// combining marks and format characters, two for wide characters and one
+// otherwise.
+func runeWidth(r rune) int {
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+
+	for _, wide := range wideRanges {
+		if r >= wide.lo && r <= wide.hi {
+			return 2
+		}
+	}
+
+	return 1
+}
+
+// displayWidth returns the number of terminal columns s takes.
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+
+	return width
+}
+
+// escapeSequence matches ANSI escape sequences: CSI sequences such as colours
+// and cursor movements, OSC sequences such as hyperlinks and window titles,
+// and two character escapes.
+var escapeSequence = regexp.MustCompile(`\x1b(?:\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)?|[@-Z\\-_])`)
+
+// sanitize removes the escape sequences and control characters of text from
+// an error, which could otherwise move the cursor or restyle the terminal.
+// Tabs become spaces and line breaks are kept.
+func sanitize(text string) string {
+	text = escapeSequence.ReplaceAllString(text, "")
+
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n':
+			return r
+		case r == '\t':
+			return ' '
+		case unicode.IsControl(r):
+			return -1
+		default:
+			return r
+		}
+	}, text)
+}
@@ -0,0 +1,255 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sarif converts Error Catalog errors into SARIF 2.1.0 tool execution
+// notifications, so that a failed scan still explains itself in SARIF
+// uploads.
+//
+// Every error becomes an entry of invocations[].toolExecutionNotifications
+// whose descriptor references a notification descriptor of the tool driver
+// carrying the title, description and documentation link of the error code.
+package sarif
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+const (
+	Version = "2.1.0"
+	Schema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// Log is the top-level SARIF document. The types below model the subset of
+// SARIF 2.1.0 needed to report tool execution notifications.
+type Log struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+type Run struct {
+	Tool        Tool         `json:"tool"`
+	Invocations []Invocation `json:"invocations,omitempty"`
+}
+
+type Tool struct {
+	Driver ToolComponent `json:"driver"`
+}
+
+type ToolComponent struct {
+	Name           string                `json:"name"`
+	Version        string                `json:"version,omitempty"`
+	InformationURI string                `json:"informationUri,omitempty"`
+	Notifications  []ReportingDescriptor `json:"notifications,omitempty"`
+}
+
+type ReportingDescriptor struct {
+	ID               string                    `json:"id"`
+	Name             string                    `json:"name,omitempty"`
+	ShortDescription *MultiformatMessageString `json:"shortDescription,omitempty"`
+	FullDescription  *MultiformatMessageString `json:"fullDescription,omitempty"`
+	HelpURI          string                    `json:"helpUri,omitempty"`
+	Properties       map[string]any            `json:"properties,omitempty"`
+}
+
+type MultiformatMessageString struct {
+	Text string `json:"text"`
+}
+
+type Invocation struct {
+	ExecutionSuccessful        bool           `json:"executionSuccessful"`
+	ToolExecutionNotifications []Notification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type Notification struct {
+	Descriptor *ReportingDescriptorReference `json:"descriptor,omitempty"`
+	Level      string                        `json:"level,omitempty"`
+	Message    Message                       `json:"message"`
+	Locations  []Location                    `json:"locations,omitempty"`
+	Properties map[string]any                `json:"properties,omitempty"`
+}
+
+type ReportingDescriptorReference struct {
+	ID    string `json:"id"`
+	Index *int   `json:"index,omitempty"`
+}
+
+type Message struct {
+	Text string `json:"text"`
+}
+
+type Location struct {
+	PhysicalLocation *PhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []LogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           *Region          `json:"region,omitempty"`
+}
+
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type Region struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type LogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind,omitempty"`
+}
+
+// Level maps an Error Catalog level onto a SARIF notification level.
+func Level(level string) string {
+	switch level {
+	case "fatal", "error":
+		return "error"
+	case "warn":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// NewDescriptor describes the error code of err as a SARIF notification
+// descriptor.
+func NewDescriptor(err snyk_errors.Error) ReportingDescriptor {
+	descriptor := ReportingDescriptor{
+		ID:      err.ErrorCode,
+		HelpURI: err.Type,
+	}
+
+	if err.Title != "" {
+		descriptor.ShortDescription = &MultiformatMessageString{Text: err.Title}
+	}
+
+	if err.Description != "" {
+		descriptor.FullDescription = &MultiformatMessageString{Text: err.Description}
+	}
+
+	if err.Classification != "" {
+		descriptor.Properties = map[string]any{"classification": err.Classification}
+	}
+
+	return descriptor
+}
+
+// NewNotification converts err into a SARIF tool execution notification. The
+// location is taken from the file or source pointer recorded in the error
+// meta, see snyk_errors.WithLocation and snyk_errors.WithSourcePointer.
+func NewNotification(err snyk_errors.Error) Notification {
+	notification := Notification{
+		Level:   Level(err.Level),
+		Message: Message{Text: message(err)},
+	}
+
+	if err.ErrorCode != "" {
+		notification.Descriptor = &ReportingDescriptorReference{ID: err.ErrorCode}
+	}
+
+	if err.ID != "" {
+		notification.Properties = map[string]any{"id": err.ID}
+	}
+
+	if loc, ok := err.Location(); ok {
+		var location Location
+
+		if loc.File != "" {
+			location.PhysicalLocation = &PhysicalLocation{
+				ArtifactLocation: ArtifactLocation{URI: loc.File},
+			}
+
+			if loc.Line > 0 {
+				location.PhysicalLocation.Region = &Region{StartLine: loc.Line, StartColumn: loc.Column}
+			}
+		}
+
+		if loc.SourcePointer != "" {
+			location.LogicalLocations = []LogicalLocation{
+				{FullyQualifiedName: loc.SourcePointer, Kind: "member"},
+			}
+		}
+
+		notification.Locations = []Location{location}
+	}
+
+	return notification
+}
+
+// NewLog creates a SARIF log with a single run of the named tool, reporting
+// errs as the tool execution notifications of one invocation. The invocation
+// is unsuccessful when any of errs maps to the SARIF error level.
+func NewLog(toolName string, errs []snyk_errors.Error) Log {
+	driver := ToolComponent{Name: toolName}
+	invocation := Invocation{ExecutionSuccessful: true}
+	indexes := make(map[string]int)
+
+	for _, err := range errs {
+		notification := NewNotification(err)
+
+		if err.ErrorCode != "" {
+			index, ok := indexes[err.ErrorCode]
+			if !ok {
+				index = len(driver.Notifications)
+				indexes[err.ErrorCode] = index
+				driver.Notifications = append(driver.Notifications, NewDescriptor(err))
+			}
+			notification.Descriptor.Index = &index
+		}
+
+		if notification.Level == "error" {
+			invocation.ExecutionSuccessful = false
+		}
+
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications, notification)
+	}
+
+	return Log{
+		Schema:  Schema,
+		Version: Version,
+		Runs: []Run{
+			{
+				Tool:        Tool{Driver: driver},
+				Invocations: []Invocation{invocation},
+			},
+		},
+	}
+}
+
+// Write encodes a SARIF log for errs, see NewLog, to w.
+func Write(w io.Writer, toolName string, errs []snyk_errors.Error) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(NewLog(toolName, errs))
+}
+
+func message(err snyk_errors.Error) string {
+	switch {
+	case err.Detail == "":
+		return err.Title
+	case err.Title == "":
+		return err.Detail
+	default:
+		return err.Title + ": " + err.Detail
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package sarif_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/code"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/sarif"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestLevel(t *testing.T) {
+	require.Equal(t, "error", sarif.Level("fatal"))
+	require.Equal(t, "error", sarif.Level("error"))
+	require.Equal(t, "warning", sarif.Level("warn"))
+	require.Equal(t, "note", sarif.Level("info"))
+}
+
+func TestNewNotification(t *testing.T) {
+	err := ecosystems.NewUnparseableManifestError("unexpected token",
+		snyk_errors.WithLocation("package.json", 3, 14),
+		snyk_errors.WithSourcePointer("/data/attributes/manifest"))
+
+	notification := sarif.NewNotification(err)
+
+	require.Equal(t, "SNYK-OS-0001", notification.Descriptor.ID)
+	require.Equal(t, "error", notification.Level)
+	require.Equal(t, "Unable to parse manifest file: unexpected token", notification.Message.Text)
+	require.Equal(t, []sarif.Location{
+		{
+			PhysicalLocation: &sarif.PhysicalLocation{
+				ArtifactLocation: sarif.ArtifactLocation{URI: "package.json"},
+				Region:           &sarif.Region{StartLine: 3, StartColumn: 14},
+			},
+			LogicalLocations: []sarif.LogicalLocation{
+				{FullyQualifiedName: "/data/attributes/manifest", Kind: "member"},
+			},
+		},
+	}, notification.Locations)
+}
+
+func TestNewLog(t *testing.T) {
+	errs := []snyk_errors.Error{
+		code.NewAnalysisFileCountLimitExceededError("120000 files"),
+		ecosystems.NewUnparseableManifestError("", snyk_errors.WithLocation("go.mod", 0, 0)),
+		code.NewAnalysisFileCountLimitExceededError("130000 files"),
+	}
+
+	log := sarif.NewLog("Snyk Code", errs)
+
+	require.Len(t, log.Runs, 1)
+	driver := log.Runs[0].Tool.Driver
+	require.Len(t, driver.Notifications, 2)
+	require.Equal(t, "SNYK-CODE-0001", driver.Notifications[0].ID)
+	require.Equal(t, "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-code-0001", driver.Notifications[0].HelpURI)
+
+	invocation := log.Runs[0].Invocations[0]
+	require.False(t, invocation.ExecutionSuccessful)
+	require.Len(t, invocation.ToolExecutionNotifications, 3)
+	require.Equal(t, 0, *invocation.ToolExecutionNotifications[2].Descriptor.Index)
+	require.Equal(t, 1, *invocation.ToolExecutionNotifications[1].Descriptor.Index)
+}
+
+func TestNewLogOnlyWarnings(t *testing.T) {
+	log := sarif.NewLog("Snyk", []snyk_errors.Error{snyk.NewTooManyRequestsError("")})
+
+	require.True(t, log.Runs[0].Invocations[0].ExecutionSuccessful)
+	require.Equal(t, "warning", log.Runs[0].Invocations[0].ToolExecutionNotifications[0].Level)
+}
+
+func TestWriteValidatesAgainstSchema(t *testing.T) {
+	schema, err := jsonschema.Compile("testdata/sarif-schema-2.1.0.json")
+	require.NoError(t, err)
+
+	errs := []snyk_errors.Error{
+		code.NewAnalysisFileCountLimitExceededError("120000 files"),
+		ecosystems.NewUnparseableManifestError("unexpected token",
+			snyk_errors.WithLocation("package.json", 3, 0),
+			snyk_errors.WithSourcePointer("/data/attributes/manifest")),
+		snyk.NewTooManyRequestsError(""),
+		{Title: "Uncatalogued failure"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, sarif.Write(&buf, "Snyk Open Source", errs))
+
+	var doc any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	require.NoError(t, schema.Validate(doc))
+}
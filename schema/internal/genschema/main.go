@@ -0,0 +1,93 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command genschema writes the JSON Schemas published by the schema package,
+// deriving them from the types encoded by package snyk_errors. Run it through
+// go generate from the schema directory.
+package main
+
+import (
+	"log"
+	"os"
+	"reflect"
+
+	"github.com/snyk/error-catalog-golang-public/internal/jsonapi"
+	"github.com/snyk/error-catalog-golang-public/internal/problem"
+	"github.com/snyk/error-catalog-golang-public/internal/schemagen"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func main() {
+	for name, s := range schemas() {
+		data, err := schemagen.Marshal(s)
+		if err != nil {
+			log.Fatalf("encoding %s: %v", name, err)
+		}
+
+		if err := os.WriteFile(name, data, 0o644); err != nil {
+			log.Fatalf("writing %s: %v", name, err)
+		}
+	}
+}
+
+// schemas returns the published schemas keyed by file name.
+func schemas() map[string]*schemagen.Schema {
+	uriReference := func(s *schemagen.Schema) *schemagen.Schema {
+		s.Format = "uri-reference"
+		return s
+	}
+
+	errorSchema := schemagen.Reflect(reflect.TypeOf(snyk_errors.Error{}))
+	errorSchema.Title = "Error Catalog error"
+	errorSchema.Description = "The JSON encoding of snyk_errors.Error."
+	uriReference(errorSchema.Properties["type"])
+	uriReference(errorSchema.Properties["links"].Items)
+
+	jsonAPI := schemagen.Reflect(reflect.TypeOf(jsonapi.Document{}))
+	jsonAPI.Title = "Error Catalog JSON:API error document"
+	jsonAPI.Description = "A JSON:API error document as written by snyk_errors.Error.MarshalToJSONAPIError."
+	jsonAPI.Properties["errors"].MinItems = 1
+	jsonAPIError := jsonAPI.Properties["errors"].Items
+	jsonAPIError.Required = append(jsonAPIError.Required, "meta")
+	uriReference(jsonAPIError.Properties["links"].Properties["about"])
+	meta := jsonAPIError.Properties["meta"]
+	meta.Properties = map[string]*schemagen.Schema{
+		"isErrorCatalogError":             {Const: true},
+		"level":                           {Type: "string", MinLength: 1},
+		"classification":                  {Type: "string", MinLength: 1},
+		snyk_errors.MetaKeyCatalogVersion: {Type: "string"},
+		"logs":                            {Type: "string"},
+	}
+	meta.Required = []string{"isErrorCatalogError", "level", "classification"}
+
+	problemSchema := schemagen.Reflect(reflect.TypeOf(problem.Document{}))
+	problemSchema.Title = "Error Catalog problem details"
+	problemSchema.Description = "An " + snyk_errors.ProblemJSONContentType + " document (RFC 9457) as written by snyk_errors.Error.MarshalToProblemJSON."
+	uriReference(problemSchema.Properties["type"])
+	uriReference(problemSchema.Properties["instance"])
+	uriReference(problemSchema.Properties["links"].Items)
+
+	result := map[string]*schemagen.Schema{
+		"error.schema.json":         errorSchema,
+		"jsonapi-error.schema.json": jsonAPI,
+		"problem.schema.json":       problemSchema,
+	}
+	for _, s := range result {
+		s.Schema = schemagen.Draft
+	}
+
+	return result
+}
@@ -0,0 +1,40 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/internal/schemagen"
+)
+
+// TestSchemasAreUpToDate keeps the published schemas in sync with the types.
+func TestSchemasAreUpToDate(t *testing.T) {
+	for name, s := range schemas() {
+		t.Run(name, func(t *testing.T) {
+			expected, err := schemagen.Marshal(s)
+			require.NoError(t, err)
+
+			actual, err := os.ReadFile(filepath.Join("..", "..", name))
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(actual), "run go generate ./schema")
+		})
+	}
+}
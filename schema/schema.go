@@ -0,0 +1,127 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schema publishes JSON Schemas of the encodings of Error Catalog
+// errors, and validates payloads against them without network access, for
+// example in contract tests:
+//
+//	var buf bytes.Buffer
+//	err := snyk.NewBadRequestError("invalid org").MarshalToJSONAPIError(&buf, "/orgs")
+//	...
+//	err = schema.Validate(schema.JSONAPI, buf.Bytes())
+//
+// The schemas are derived from the types of package snyk_errors. Run
+// go generate ./schema after changing them.
+package schema
+
+//go:generate go run ./internal/genschema
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Name is the file name of a published schema.
+type Name string
+
+const (
+	// Error is the schema of the JSON encoding of snyk_errors.Error.
+	Error Name = "error.schema.json"
+	// JSONAPI is the schema of the JSON:API error documents written by
+	// snyk_errors.Error.MarshalToJSONAPIError.
+	JSONAPI Name = "jsonapi-error.schema.json"
+	// Problem is the schema of the application/problem+json documents written
+	// by snyk_errors.Error.MarshalToProblemJSON.
+	Problem Name = "problem.schema.json"
+)
+
+// Names lists the published schemas.
+var Names = []Name{Error, JSONAPI, Problem}
+
+//go:embed *.schema.json
+var files embed.FS
+
+var (
+	compileOnce sync.Once
+	compiled    map[Name]*jsonschema.Schema
+	compileErr  error
+)
+
+// JSON returns the schema document of name.
+func JSON(name Name) ([]byte, error) {
+	return files.ReadFile(string(name))
+}
+
+// Validate checks that data is a JSON document matching the schema name. The
+// schemas are embedded, validation never accesses the network.
+func Validate(name Name, data []byte) error {
+	compileOnce.Do(compile)
+	if compileErr != nil {
+		return compileErr
+	}
+
+	s, ok := compiled[name]
+	if !ok {
+		return fmt.Errorf("unknown schema %q", name)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return s.Validate(v)
+}
+
+func compile() {
+	c := jsonschema.NewCompiler()
+	c.AssertFormat = true
+	c.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("loading %s: schemas are validated offline", url)
+	}
+
+	for _, name := range Names {
+		data, err := JSON(name)
+		if err != nil {
+			compileErr = err
+			return
+		}
+
+		if err := c.AddResource(string(name), bytes.NewReader(data)); err != nil {
+			compileErr = err
+			return
+		}
+	}
+
+	compiled = make(map[Name]*jsonschema.Schema, len(Names))
+	for _, name := range Names {
+		s, err := c.Compile(string(name))
+		if err != nil {
+			compileErr = err
+			return
+		}
+		compiled[name] = s
+	}
+}
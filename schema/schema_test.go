@@ -0,0 +1,131 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package schema_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/cli"
+	"github.com/snyk/error-catalog-golang-public/schema"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestValidate_Encodings(t *testing.T) {
+	errs := []snyk_errors.Error{
+		snyk.NewBadRequestError("invalid org"),
+		cli.NewConnectionTimeoutError("timed out",
+			snyk_errors.WithCause(errors.New("dial tcp: i/o timeout")),
+			snyk_errors.WithLogs([]string{"retrying", "giving up"}),
+			snyk_errors.WithMeta("attempts", 3),
+		),
+	}
+
+	for _, err := range errs {
+		t.Run(err.ErrorCode, func(t *testing.T) {
+			data, marshalErr := json.Marshal(err)
+			require.NoError(t, marshalErr)
+			require.NoError(t, schema.Validate(schema.Error, data))
+
+			var jsonAPI bytes.Buffer
+			require.NoError(t, err.MarshalToJSONAPIError(&jsonAPI, "/orgs/1"))
+			require.NoError(t, schema.Validate(schema.JSONAPI, jsonAPI.Bytes()))
+
+			var problem bytes.Buffer
+			require.NoError(t, err.MarshalToProblemJSON(&problem, "/orgs/1"))
+			require.NoError(t, schema.Validate(schema.Problem, problem.Bytes()))
+		})
+	}
+}
+
+func TestValidate_Invalid(t *testing.T) {
+	type test struct {
+		description string
+		name        schema.Name
+		payload     string
+		err         string
+	}
+
+	tests := []test{
+		{
+			description: "JSON:API error not from the catalog",
+			name:        schema.JSONAPI,
+			payload:     `{"jsonapi":{"version":"1.0"},"errors":[{"code":"SNYK-0003","meta":{"level":"error","classification":"ACTIONABLE"}}]}`,
+			err:         "isErrorCatalogError",
+		},
+		{
+			description: "JSON:API error without level",
+			name:        schema.JSONAPI,
+			payload:     `{"jsonapi":{"version":"1.0"},"errors":[{"meta":{"isErrorCatalogError":true,"classification":"ACTIONABLE"}}]}`,
+			err:         "level",
+		},
+		{
+			description: "JSON:API document without errors",
+			name:        schema.JSONAPI,
+			payload:     `{"jsonapi":{"version":"1.0"},"errors":[]}`,
+			err:         "minimum 1 items",
+		},
+		{
+			description: "problem without classification",
+			name:        schema.Problem,
+			payload:     `{"title":"Bad request","errorCode":"SNYK-0003","level":"error"}`,
+			err:         "classification",
+		},
+		{
+			description: "problem with a status which is not a number",
+			name:        schema.Problem,
+			payload:     `{"title":"Bad request","status":"400","errorCode":"SNYK-0003","classification":"ACTIONABLE","level":"error"}`,
+			err:         "status",
+		},
+		{
+			description: "error with a status code which is not a number",
+			name:        schema.Error,
+			payload:     `{"statusCode":"400"}`,
+			err:         "expected integer",
+		},
+		{
+			description: "invalid JSON",
+			name:        schema.Error,
+			payload:     `{`,
+			err:         "invalid JSON",
+		},
+		{
+			description: "unknown schema",
+			name:        "sarif.schema.json",
+			payload:     `{}`,
+			err:         `unknown schema "sarif.schema.json"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.ErrorContains(t, schema.Validate(tt.name, []byte(tt.payload)), tt.err)
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	for _, name := range schema.Names {
+		data, err := schema.JSON(name)
+		require.NoError(t, err)
+		require.True(t, json.Valid(data), name)
+	}
+}
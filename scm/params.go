@@ -0,0 +1,41 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package scm
+
+import (
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+var (
+	filesLimitExceededDetail = snyk_errors.MustParseDetail("SNYK-SCM-0005", "The repository{{if .Path}} {{.Path}}{{end}}{{if .Actual}} contains {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	sizeLimitExceededDetail  = snyk_errors.MustParseDetail("SNYK-SCM-0006", "The repository{{if .Path}} {{.Path}}{{end}}{{if .Actual}} is {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+)
+
+// NewFilesLimitExceededErrorWithParams is NewFilesLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewFilesLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewFilesLimitExceededError(snyk_errors.RenderDetail(filesLimitExceededDetail, params), options...)
+}
+
+// NewSizeLimitExceededErrorWithParams is NewSizeLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewSizeLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewSizeLimitExceededError(snyk_errors.RenderDetail(sizeLimitExceededDetail, params), options...)
+}
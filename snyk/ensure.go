@@ -0,0 +1,37 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk
+
+import (
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+// Ensure returns the first catalog error in the chain of err, see
+// snyk_errors.AsCatalogError. Errors without catalog error are wrapped in
+// NewServerError, with their message as detail and err as cause. A nil err,
+// including a nil *snyk_errors.Error, is no error, so Ensure returns the zero
+// Error and false.
+func Ensure(err error) (snyk_errors.Error, bool) {
+	if catalogErr, ok := err.(*snyk_errors.Error); err == nil || ok && catalogErr == nil {
+		return snyk_errors.Error{}, false
+	}
+
+	if catalogErr, ok := snyk_errors.AsCatalogError(err); ok {
+		return catalogErr, true
+	}
+
+	return NewServerError(err.Error(), snyk_errors.WithCause(err)), true
+}
@@ -0,0 +1,60 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/errorcodes"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestEnsure(t *testing.T) {
+	throttled := snyk.NewTooManyRequestsError("slow down")
+
+	actual, ok := snyk.Ensure(fmt.Errorf("calling the API: %w", &throttled))
+	require.True(t, ok)
+	require.Equal(t, throttled, actual)
+
+	cause := fmt.Errorf("calling the API: %w", context.Canceled)
+	actual, ok = snyk.Ensure(cause)
+	require.True(t, ok)
+	require.Equal(t, errorcodes.Snyk.ServerError, actual.ErrorCode)
+	require.Equal(t, "calling the API: context canceled", actual.Detail)
+	require.Equal(t, cause, actual.Cause)
+	require.ErrorIs(t, actual, context.Canceled)
+
+	actual, ok = snyk.Ensure(context.DeadlineExceeded)
+	require.True(t, ok)
+	require.True(t, errors.Is(actual, context.DeadlineExceeded))
+}
+
+func TestEnsure_Nil(t *testing.T) {
+	actual, ok := snyk.Ensure(nil)
+	require.False(t, ok)
+	require.Equal(t, snyk_errors.Error{}, actual)
+
+	var typed *snyk_errors.Error
+	actual, ok = snyk.Ensure(typed)
+	require.False(t, ok)
+	require.Equal(t, snyk_errors.Error{}, actual)
+}
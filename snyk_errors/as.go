@@ -0,0 +1,81 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+// AsCatalogError returns the first catalog error in the chain of err. Unlike
+// errors.As with an Error target, it also finds *Error values. It returns
+// false if the chain holds no catalog error.
+func AsCatalogError(err error) (Error, bool) {
+	var result Error
+	found := false
+
+	walk(err, 0, func(c Candidate) bool {
+		result, found = c.Error, true
+		return false
+	})
+
+	return result, found
+}
+
+// AllCatalogErrors returns every catalog error in the tree of err, including
+// the causes of catalog errors and every branch of errors.Join and
+// MultiError, in depth first order.
+func AllCatalogErrors(err error) []Error {
+	var errs []Error
+
+	walk(err, 0, func(c Candidate) bool {
+		errs = append(errs, c.Error)
+		return true
+	})
+
+	return errs
+}
+
+// walk calls visit for every catalog error in the tree of err, in depth
+// first order, until visit returns false. It reports whether the walk
+// completed.
+func walk(err error, depth int, visit func(Candidate) bool) bool {
+	if err == nil {
+		return true
+	}
+
+	switch e := err.(type) {
+	case Error:
+		if !visit(Candidate{Error: e, Depth: depth}) {
+			return false
+		}
+	case *Error:
+		if e == nil {
+			return true
+		}
+		if !visit(Candidate{Error: *e, Depth: depth}) {
+			return false
+		}
+	}
+
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return walk(u.Unwrap(), depth+1, visit)
+	case interface{ Unwrap() []error }:
+		for _, inner := range u.Unwrap() {
+			if !walk(inner, depth+1, visit) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
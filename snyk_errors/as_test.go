@@ -0,0 +1,82 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/prchecks"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestAsCatalogError(t *testing.T) {
+	manifest := ecosystems.NewUnparseableManifestError("package.json")
+	wrapper := prchecks.NewFailedToCompleteTestError("", snyk_errors.WithCause(manifest))
+
+	type test struct {
+		description string
+		err         error
+		expected    string
+	}
+
+	tests := []test{
+		{description: "value", err: fmt.Errorf("scan: %w", manifest), expected: manifest.ErrorCode},
+		{description: "pointer", err: fmt.Errorf("scan: %w", &manifest), expected: manifest.ErrorCode},
+		{description: "outermost first", err: wrapper, expected: wrapper.ErrorCode},
+		{description: "joined", err: errors.Join(errors.New("plain"), &manifest), expected: manifest.ErrorCode},
+		{description: "nil pointer", err: fmt.Errorf("scan: %w", (*snyk_errors.Error)(nil))},
+		{description: "plain", err: errors.New("plain")},
+		{description: "nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, ok := snyk_errors.AsCatalogError(tt.err)
+			require.Equal(t, tt.expected != "", ok)
+			require.Equal(t, tt.expected, actual.ErrorCode)
+		})
+	}
+
+	var target snyk_errors.Error
+	require.False(t, errors.As(fmt.Errorf("scan: %w", &manifest), &target), "errors.As misses pointers")
+}
+
+func TestAllCatalogErrors(t *testing.T) {
+	manifest := ecosystems.NewUnparseableManifestError("package.json")
+	lockFile := ecosystems.NewUnparseableLockFileError("yarn.lock")
+	wrapper := prchecks.NewFailedToCompleteTestError("", snyk_errors.WithCause(manifest))
+
+	err := errors.Join(
+		fmt.Errorf("project a: %w", wrapper),
+		errors.New("project b: plain"),
+		fmt.Errorf("project c: %w", &lockFile),
+		snyk_errors.NewMultiError(lockFile, manifest),
+	)
+
+	var codes []string
+	for _, e := range snyk_errors.AllCatalogErrors(err) {
+		codes = append(codes, e.ErrorCode)
+	}
+	require.Equal(t, []string{wrapper.ErrorCode, manifest.ErrorCode, lockFile.ErrorCode, lockFile.ErrorCode, manifest.ErrorCode}, codes)
+
+	require.Empty(t, snyk_errors.AllCatalogErrors(errors.New("plain")))
+	require.Empty(t, snyk_errors.AllCatalogErrors(nil))
+}
@@ -21,37 +21,26 @@ import (
 	"strconv"
 
 	_ "github.com/google/uuid"
-)
-
-type jsonAPIDoc struct {
-	JSONAPI jsonAPIObject  `json:"jsonapi"`
-	Errors  []jsonAPIError `json:"errors"`
-}
-
-type jsonAPIObject struct {
-	Version string `json:"version"`
-}
 
-type jsonAPIError struct {
-	ID     string                 `json:"id,omitempty"`
-	Title  string                 `json:"title,omitempty"`
-	Detail string                 `json:"detail,omitempty"`
-	Status string                 `json:"status,omitempty"`
-	Code   string                 `json:"code,omitempty"`
-	Meta   map[string]interface{} `json:"meta,omitempty"`
-	Links  jsonAPILinks           `json:"links,omitempty"`
-	Source jsonAPIErrSource       `json:"source,omitempty"`
-}
+	"github.com/snyk/error-catalog-golang-public/internal/jsonapi"
+)
 
-type jsonAPILinks struct {
-	About string `json:"about,omitempty"`
-}
+// MetaKeyCatalogVersion is the meta key of JSON:API errors holding the
+// CatalogVersion of the encoder.
+const MetaKeyCatalogVersion = "catalogVersion"
 
-type jsonAPIErrSource struct {
-	Pointer string `json:"pointer,omitempty"`
-}
+type (
+	jsonAPIDoc       jsonapi.Document
+	jsonAPIObject    = jsonapi.Object
+	jsonAPIError     = jsonapi.Error
+	jsonAPILinks     = jsonapi.Links
+	jsonAPIErrSource = jsonapi.Source
+)
 
-func FromJSONAPIErrorBytes(data []byte) ([]Error, error) {
+// FromJSONAPIErrorBytes decodes the errors of a JSON:API error document. The
+// options are applied to every decoded error, which allows consumers to
+// post-process errors received over the wire.
+func FromJSONAPIErrorBytes(data []byte, options ...Option) ([]Error, error) {
 	var jsonDoc jsonAPIDoc
 
 	err := json.Unmarshal(data, &jsonDoc)
@@ -59,10 +48,21 @@ func FromJSONAPIErrorBytes(data []byte) ([]Error, error) {
 		return nil, err
 	}
 
-	return jsonDoc.MarshalFromJSONAPIError(), nil
+	errs := jsonDoc.MarshalFromJSONAPIError()
+	for i := range errs {
+		for _, option := range options {
+			option(&errs[i])
+		}
+	}
+
+	return errs, nil
 }
 
 func (e Error) MarshalToJSONAPIError(w io.Writer, instance string) error {
+	return encodeJSONAPIErrors(w, []jsonAPIError{e.toJSONAPIError(instance)})
+}
+
+func (e Error) toJSONAPIError(instance string) jsonAPIError {
 	err := jsonAPIError{
 		ID:     e.ID,
 		Title:  e.Title,
@@ -92,12 +92,17 @@ func (e Error) MarshalToJSONAPIError(w io.Writer, instance string) error {
 
 	// Allow consumers to probe if this specific type of JsonApi response originates from an error catalog error.
 	err.Meta["isErrorCatalogError"] = true
+	err.Meta[MetaKeyCatalogVersion] = CatalogVersion
+
+	return err
+}
 
+func encodeJSONAPIErrors(w io.Writer, errs []jsonAPIError) error {
 	return json.NewEncoder(w).Encode(jsonAPIDoc{
 		JSONAPI: jsonAPIObject{
 			Version: "1.0",
 		},
-		Errors: []jsonAPIError{err},
+		Errors: errs,
 	})
 }
 
@@ -63,6 +63,7 @@ func TestMarshalToJSONAPIError(t *testing.T) {
 							"level":               "level",
 							"classification":      "ACTIONABLE",
 							"isErrorCatalogError": true,
+							MetaKeyCatalogVersion: CatalogVersion,
 						},
 						Links: jsonAPILinks{
 							About: "type",
@@ -106,6 +107,7 @@ func TestMarshalToJSONAPIError(t *testing.T) {
 							"level":               "level",
 							"classification":      "ACTIONABLE",
 							"isErrorCatalogError": true,
+							MetaKeyCatalogVersion: CatalogVersion,
 						},
 						Links: jsonAPILinks{
 							About: "type",
@@ -149,6 +151,7 @@ func TestMarshalToJSONAPIError(t *testing.T) {
 							"level":               "warn",
 							"classification":      "ACTIONABLE",
 							"isErrorCatalogError": true,
+							MetaKeyCatalogVersion: CatalogVersion,
 							"logs":                "[\"a\",\"b\"]",
 						},
 						Links: jsonAPILinks{
@@ -286,3 +289,19 @@ func TestFromJSONAPIErrorBytes(t *testing.T) {
 
 	require.Equal(t, expected, errors)
 }
+
+func TestFromJSONAPIErrorBytes_Options(t *testing.T) {
+	data := []byte(`{"jsonapi":{"version":"1.0"},"errors":[{"code":"SNYK-0001"},{"code":"SNYK-0002"}]}`)
+
+	var seen []string
+	errors, err := FromJSONAPIErrorBytes(data,
+		func(e *Error) { seen = append(seen, e.ErrorCode) },
+		WithLinks([]string{"https://snyk.io"}),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"SNYK-0001", "SNYK-0002"}, seen)
+	for _, e := range errors {
+		require.Equal(t, []string{"https://snyk.io"}, e.Links)
+	}
+}
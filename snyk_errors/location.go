@@ -0,0 +1,94 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Meta keys used to locate an error within a file or a request document.
+const (
+	MetaKeyFile          = "file"
+	MetaKeyLine          = "line"
+	MetaKeyColumn        = "column"
+	MetaKeySourcePointer = "sourcePointer"
+)
+
+// Location points at the origin of an error. File, Line and Column locate it
+// within a file; SourcePointer is a JSON pointer into a request document.
+type Location struct {
+	File          string
+	Line          int
+	Column        int
+	SourcePointer string
+}
+
+// WithLocation records the file, and optionally the 1-based line and column,
+// which caused the error. Zero line or column values are omitted.
+func WithLocation(file string, line, column int) Option {
+	return func(e *Error) {
+		WithMeta(MetaKeyFile, file)(e)
+
+		if line > 0 {
+			WithMeta(MetaKeyLine, line)(e)
+		}
+
+		if column > 0 {
+			WithMeta(MetaKeyColumn, column)(e)
+		}
+	}
+}
+
+// WithSourcePointer records the JSON pointer to the part of the request
+// document which caused the error.
+func WithSourcePointer(pointer string) Option {
+	return WithMeta(MetaKeySourcePointer, pointer)
+}
+
+// Location returns the location recorded in the meta of e. It also accepts
+// values which went through a JSON round trip, where numbers are decoded as
+// float64.
+func (e Error) Location() (Location, bool) {
+	var loc Location
+
+	loc.File, _ = e.Meta[MetaKeyFile].(string)
+	loc.SourcePointer, _ = e.Meta[MetaKeySourcePointer].(string)
+	loc.Line = int(metaInt(e.Meta[MetaKeyLine]))
+	loc.Column = int(metaInt(e.Meta[MetaKeyColumn]))
+
+	return loc, loc.File != "" || loc.SourcePointer != ""
+}
+
+// metaInt reads an integer meta value, which may have been decoded from JSON.
+func metaInt(v any) int64 {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	case json.Number:
+		i, _ := n.Int64()
+		return i
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,53 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorLocation(t *testing.T) {
+	var err Error
+
+	_, ok := err.Location()
+	require.False(t, ok)
+
+	WithLocation("go.mod", 3, 0)(&err)
+	WithSourcePointer("/data/attributes/manifest")(&err)
+
+	loc, ok := err.Location()
+	require.True(t, ok)
+	require.Equal(t, Location{File: "go.mod", Line: 3, SourcePointer: "/data/attributes/manifest"}, loc)
+	require.NotContains(t, err.Meta, MetaKeyColumn)
+}
+
+func TestErrorLocationAfterJSONAPIRoundTrip(t *testing.T) {
+	var err Error
+	WithLocation("pom.xml", 12, 7)(&err)
+
+	var buf bytes.Buffer
+	require.NoError(t, err.MarshalToJSONAPIError(&buf, "instance"))
+
+	errs, decodeErr := FromJSONAPIErrorBytes(buf.Bytes())
+	require.NoError(t, decodeErr)
+
+	loc, ok := errs[0].Location()
+	require.True(t, ok)
+	require.Equal(t, Location{File: "pom.xml", Line: 12, Column: 7}, loc)
+}
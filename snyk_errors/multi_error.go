@@ -0,0 +1,186 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrEmptyMultiError is returned when encoding a MultiError without errors,
+// which has no valid JSON:API error document.
+var ErrEmptyMultiError = errors.New("no errors to encode")
+
+// MultiError collects catalog errors, for example the failures of the
+// manifests of a monorepo scan. The zero value is an empty MultiError ready
+// to use. A nil *MultiError is empty as well, its methods other than Add
+// can be called on it.
+type MultiError struct {
+	errs []Error
+	seen map[multiErrorKey]bool
+}
+
+// multiErrorKey identifies duplicates: errors with the same code, detail and
+// location. Errors for different files or lines are kept apart.
+type multiErrorKey struct {
+	code     string
+	detail   string
+	location Location
+}
+
+var _ error = (*MultiError)(nil)
+
+// NewMultiError returns a MultiError holding errs, see MultiError.Add.
+func NewMultiError(errs ...Error) *MultiError {
+	m := &MultiError{}
+	m.Add(errs...)
+
+	return m
+}
+
+// Add appends errs, skipping errors with the same code, detail and Location
+// as an error already collected.
+func (m *MultiError) Add(errs ...Error) {
+	if m.seen == nil {
+		m.seen = make(map[multiErrorKey]bool)
+	}
+
+	for _, err := range errs {
+		location, _ := err.Location()
+		key := multiErrorKey{code: err.ErrorCode, detail: err.Detail, location: location}
+		if m.seen[key] {
+			continue
+		}
+
+		m.seen[key] = true
+		m.errs = append(m.errs, err)
+	}
+}
+
+// Len returns the number of collected errors.
+func (m *MultiError) Len() int {
+	return len(m.list())
+}
+
+// Errors returns the collected errors in the order they were added.
+func (m *MultiError) Errors() []Error {
+	return append([]Error(nil), m.list()...)
+}
+
+// list returns the collected errors, none for a nil MultiError.
+func (m *MultiError) list() []Error {
+	if m == nil {
+		return nil
+	}
+
+	return m.errs
+}
+
+// ErrorOrNil returns m if it holds errors and nil otherwise, so that a
+// MultiError can be returned as error without producing a non-nil empty
+// error.
+func (m *MultiError) ErrorOrNil() error {
+	if m.Len() == 0 {
+		return nil
+	}
+
+	return m
+}
+
+// Error returns the messages of the collected errors, one per line.
+func (m *MultiError) Error() string {
+	messages := make([]string, m.Len())
+	for i, err := range m.list() {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the collected errors, so that errors.Is and errors.As
+// consider each of them.
+func (m *MultiError) Unwrap() []error {
+	errs := make([]error, m.Len())
+	for i, err := range m.list() {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// Worst returns the most severe error: the one with the highest level, fatal
+// before error before warn, and among those the one with the most severe
+// classification, UNEXPECTED before UNSUPPORTED before ACTIONABLE. Ties go to
+// the error added first. It returns false if m is empty.
+func (m *MultiError) Worst() (Error, bool) {
+	errs := m.list()
+	if len(errs) == 0 {
+		return Error{}, false
+	}
+
+	worst := errs[0]
+	for _, err := range errs[1:] {
+		if compareSeverity(err, worst) > 0 {
+			worst = err
+		}
+	}
+
+	return worst, true
+}
+
+// ByCode groups the collected errors by error code, keeping the order in
+// which they were added within each group.
+func (m *MultiError) ByCode() map[string][]Error {
+	groups := make(map[string][]Error)
+	for _, err := range m.list() {
+		groups[err.ErrorCode] = append(groups[err.ErrorCode], err)
+	}
+
+	return groups
+}
+
+// MarshalToJSONAPIError encodes the collected errors as a single JSON:API
+// error document with an entry per error, see Error.MarshalToJSONAPIError. It
+// returns ErrEmptyMultiError and writes nothing if m is empty, as error
+// documents hold at least one error.
+func (m *MultiError) MarshalToJSONAPIError(w io.Writer, instance string) error {
+	if m.Len() == 0 {
+		return ErrEmptyMultiError
+	}
+
+	errs := make([]jsonAPIError, m.Len())
+	for i, err := range m.list() {
+		errs[i] = err.toJSONAPIError(instance)
+	}
+
+	return encodeJSONAPIErrors(w, errs)
+}
+
+var (
+	levelSeverity          = map[string]int{"warn": 1, "error": 2, "fatal": 3}
+	classificationSeverity = map[string]int{"ACTIONABLE": 1, "UNSUPPORTED": 2, "UNEXPECTED": 3}
+)
+
+// compareSeverity orders errors by level, then by classification. Unknown
+// levels and classifications are the least severe.
+func compareSeverity(a, b Error) int {
+	if d := levelSeverity[a.Level] - levelSeverity[b.Level]; d != 0 {
+		return d
+	}
+
+	return classificationSeverity[a.Classification] - classificationSeverity[b.Classification]
+}
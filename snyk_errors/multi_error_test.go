@@ -0,0 +1,204 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/golang"
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems/nodejs"
+	"github.com/snyk/error-catalog-golang-public/snyk"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestMultiError(t *testing.T) {
+	lockFile := ecosystems.NewUnparseableLockFileError("packages/a/yarn.lock")
+	pnpm := nodejs.NewPnpmOutOfSyncError("packages/b/pnpm-lock.yaml")
+	goMod := golang.NewGoModFileMissingError("services/c")
+
+	m := snyk_errors.NewMultiError(lockFile, pnpm)
+	m.Add(goMod, ecosystems.NewUnparseableLockFileError("packages/a/yarn.lock"))
+	m.Add(ecosystems.NewUnparseableLockFileError("packages/d/yarn.lock"))
+
+	require.Equal(t, 4, m.Len(), "duplicates are skipped")
+	require.Equal(t, lockFile, m.Errors()[0])
+	require.Equal(t, lockFile.Title+"\n"+pnpm.Title+"\n"+goMod.Title+"\n"+lockFile.Title, m.Error())
+
+	var target snyk_errors.Error
+	require.ErrorAs(t, m, &target)
+	require.Equal(t, lockFile, target)
+
+	groups := m.ByCode()
+	require.Len(t, groups, 3)
+	require.Len(t, groups[lockFile.ErrorCode], 2)
+	require.Equal(t, "packages/d/yarn.lock", groups[lockFile.ErrorCode][1].Detail)
+	require.Equal(t, []snyk_errors.Error{goMod}, groups[goMod.ErrorCode])
+}
+
+func TestMultiError_Locations(t *testing.T) {
+	m := snyk_errors.NewMultiError(
+		ecosystems.NewUnparseableManifestError("unexpected token", snyk_errors.WithLocation("a/package.json", 3, 1)),
+		ecosystems.NewUnparseableManifestError("unexpected token", snyk_errors.WithLocation("b/package.json", 3, 1)),
+		ecosystems.NewUnparseableManifestError("unexpected token", snyk_errors.WithLocation("a/package.json", 7, 1)),
+		ecosystems.NewUnparseableManifestError("unexpected token", snyk_errors.WithLocation("a/package.json", 3, 1)),
+	)
+
+	require.Equal(t, 3, m.Len(), "only errors at the same location are duplicates")
+
+	var files []string
+	for _, err := range m.Errors() {
+		location, ok := err.Location()
+		require.True(t, ok)
+		files = append(files, location.File)
+	}
+	require.Equal(t, []string{"a/package.json", "b/package.json", "a/package.json"}, files)
+}
+
+func TestMultiError_ErrorOrNil(t *testing.T) {
+	var m *snyk_errors.MultiError
+	require.NoError(t, m.ErrorOrNil())
+
+	m = &snyk_errors.MultiError{}
+	require.NoError(t, m.ErrorOrNil())
+
+	m.Add(snyk.NewServerError(""))
+	require.Error(t, m.ErrorOrNil())
+}
+
+func TestMultiError_Nil(t *testing.T) {
+	var m *snyk_errors.MultiError
+
+	require.Equal(t, 0, m.Len())
+	require.Empty(t, m.Errors())
+	require.Empty(t, m.ByCode())
+	require.Empty(t, m.Unwrap())
+	require.Equal(t, "", m.Error())
+
+	_, ok := m.Worst()
+	require.False(t, ok)
+
+	var buf bytes.Buffer
+	require.ErrorIs(t, m.MarshalToJSONAPIError(&buf, ""), snyk_errors.ErrEmptyMultiError)
+	require.Empty(t, buf.String())
+}
+
+func TestMultiError_MarshalEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.ErrorIs(t, snyk_errors.NewMultiError().MarshalToJSONAPIError(&buf, ""), snyk_errors.ErrEmptyMultiError)
+	require.Empty(t, buf.String())
+}
+
+func TestMultiError_Worst(t *testing.T) {
+	type test struct {
+		description string
+		errs        []snyk_errors.Error
+		expected    string
+	}
+
+	tests := []test{
+		{
+			description: "higher level wins",
+			errs: []snyk_errors.Error{
+				{ErrorCode: "A", Level: "warn", Classification: "UNEXPECTED"},
+				{ErrorCode: "B", Level: "error", Classification: "ACTIONABLE"},
+				{ErrorCode: "C", Level: "fatal", Classification: "ACTIONABLE"},
+			},
+			expected: "C",
+		},
+		{
+			description: "classification breaks ties",
+			errs: []snyk_errors.Error{
+				{ErrorCode: "A", Level: "error", Classification: "ACTIONABLE"},
+				{ErrorCode: "B", Level: "error", Classification: "UNEXPECTED"},
+				{ErrorCode: "C", Level: "error", Classification: "UNSUPPORTED"},
+			},
+			expected: "B",
+		},
+		{
+			description: "first added wins ties",
+			errs: []snyk_errors.Error{
+				{ErrorCode: "A", Level: "error", Classification: "ACTIONABLE"},
+				{ErrorCode: "B", Level: "error", Classification: "ACTIONABLE"},
+			},
+			expected: "A",
+		},
+		{
+			description: "unknown level is the least severe",
+			errs: []snyk_errors.Error{
+				{ErrorCode: "A", Level: "debug", Classification: "UNEXPECTED"},
+				{ErrorCode: "B", Level: "warn", Classification: "ACTIONABLE"},
+			},
+			expected: "B",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			worst, ok := snyk_errors.NewMultiError(tt.errs...).Worst()
+			require.True(t, ok)
+			require.Equal(t, tt.expected, worst.ErrorCode)
+		})
+	}
+
+	_, ok := snyk_errors.NewMultiError().Worst()
+	require.False(t, ok)
+}
+
+func TestMultiError_MarshalToJSONAPIError(t *testing.T) {
+	lockFile := ecosystems.NewUnparseableLockFileError("packages/a/yarn.lock")
+	goMod := golang.NewGoModFileMissingError("services/c", snyk_errors.WithLogs([]string{"go: no go.mod"}))
+
+	var buf bytes.Buffer
+	require.NoError(t, snyk_errors.NewMultiError(lockFile, goMod).MarshalToJSONAPIError(&buf, "/scan"))
+
+	decoded, err := snyk_errors.FromJSONAPIErrorBytes(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+
+	for i, expected := range []snyk_errors.Error{lockFile, goMod} {
+		require.Equal(t, expected.ID, decoded[i].ID)
+		require.Equal(t, expected.ErrorCode, decoded[i].ErrorCode)
+		require.Equal(t, expected.Detail, decoded[i].Detail)
+		require.Equal(t, expected.Level, decoded[i].Level)
+		require.Equal(t, expected.Classification, decoded[i].Classification)
+		require.Equal(t, true, decoded[i].Meta["isErrorCatalogError"])
+	}
+
+	var doc struct {
+		Errors []struct {
+			Source struct {
+				Pointer string `json:"pointer"`
+			} `json:"source"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	require.Equal(t, "/scan", doc.Errors[1].Source.Pointer)
+}
+
+func TestMultiError_Unwrap(t *testing.T) {
+	serverErr := snyk.NewServerError("boom")
+	wrapped := errors.Join(errors.New("scan failed"), snyk_errors.NewMultiError(serverErr))
+
+	var target snyk_errors.Error
+	require.ErrorAs(t, wrapped, &target)
+	require.Equal(t, serverErr.ErrorCode, target.ErrorCode)
+}
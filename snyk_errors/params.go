@@ -0,0 +1,115 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Meta keys holding the parameters of errors reporting an exceeded limit.
+const (
+	MetaKeyLimit  = "limit"
+	MetaKeyActual = "actual"
+	MetaKeyUnit   = "unit"
+)
+
+// LimitParams are the parameters of errors reporting an exceeded limit, such
+// as a file size or a file count. Actual, Unit and Path are optional. Path is
+// stored in meta under MetaKeyFile, like the file of a Location.
+type LimitParams struct {
+	Limit  int64
+	Actual int64
+	Unit   string
+	Path   string
+}
+
+// WithLimitParams stores params as structured meta, so that clients can read
+// them back with Error.LimitParams. Zero optional values are omitted.
+func WithLimitParams(params LimitParams) Option {
+	return func(e *Error) {
+		WithMeta(MetaKeyLimit, params.Limit)(e)
+
+		if params.Actual != 0 {
+			WithMeta(MetaKeyActual, params.Actual)(e)
+		}
+
+		if params.Unit != "" {
+			WithMeta(MetaKeyUnit, params.Unit)(e)
+		}
+
+		if params.Path != "" {
+			WithMeta(MetaKeyFile, params.Path)(e)
+		}
+	}
+}
+
+// LimitParams returns the limit parameters stored in the meta of e. It also
+// accepts values which went through a JSON round trip.
+func (e Error) LimitParams() (LimitParams, bool) {
+	if _, ok := e.Meta[MetaKeyLimit]; !ok {
+		return LimitParams{}, false
+	}
+
+	params := LimitParams{
+		Limit:  metaInt(e.Meta[MetaKeyLimit]),
+		Actual: metaInt(e.Meta[MetaKeyActual]),
+	}
+	params.Unit, _ = e.Meta[MetaKeyUnit].(string)
+	params.Path, _ = e.Meta[MetaKeyFile].(string)
+
+	return params, true
+}
+
+// LimitWithUnit renders Limit followed by Unit, if any, for detail templates.
+func (p LimitParams) LimitWithUnit() string {
+	return withUnit(p.Limit, p.Unit)
+}
+
+// ActualWithUnit renders Actual followed by Unit, if any, for detail
+// templates.
+func (p LimitParams) ActualWithUnit() string {
+	return withUnit(p.Actual, p.Unit)
+}
+
+func withUnit(value int64, unit string) string {
+	if unit == "" {
+		return strconv.FormatInt(value, 10)
+	}
+
+	return strconv.FormatInt(value, 10) + " " + unit
+}
+
+// MustParseDetail parses the text/template which renders the detail of the
+// error code from its parameters. It panics if text is not a valid template.
+func MustParseDetail(code, text string) *template.Template {
+	return template.Must(template.New(code).Option("missingkey=error").Parse(text))
+}
+
+// RenderDetail executes tmpl with params. If the template cannot be executed
+// the parameters are rendered verbatim instead, so that no information is
+// lost.
+func RenderDetail(tmpl *template.Template, params any) string {
+	var sb strings.Builder
+
+	if err := tmpl.Execute(&sb, params); err != nil {
+		return fmt.Sprintf("%+v", params)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,94 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/code"
+	"github.com/snyk/error-catalog-golang-public/scm"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+	"github.com/snyk/error-catalog-golang-public/uploadrevision"
+)
+
+func ExampleWithLimitParams() {
+	err := uploadrevision.NewFileTooLargeErrorWithParams(snyk_errors.LimitParams{
+		Path:   "dist/bundle.js",
+		Actual: 73400320,
+		Limit:  52428800,
+		Unit:   "bytes",
+	})
+
+	params, _ := err.LimitParams()
+
+	fmt.Println(err.Detail)
+	fmt.Println(params.Limit)
+	// Output:
+	// The file dist/bundle.js is 73400320 bytes, which exceeds the limit of 52428800 bytes.
+	// 52428800
+}
+
+func TestConstructorsWithParams(t *testing.T) {
+	type test struct {
+		description string
+		new         func(snyk_errors.LimitParams, ...snyk_errors.Option) snyk_errors.Error
+		params      snyk_errors.LimitParams
+		expected    string
+	}
+
+	tests := []test{
+		{
+			description: "file count without actual value",
+			new:         code.NewAnalysisFileCountLimitExceededErrorWithParams,
+			params:      snyk_errors.LimitParams{Limit: 100000, Unit: "files"},
+			expected:    "The analysis target exceeds the limit of 100000 files.",
+		},
+		{
+			description: "file count with path and actual value",
+			new:         code.NewAnalysisFileCountLimitExceededErrorWithParams,
+			params:      snyk_errors.LimitParams{Limit: 100000, Actual: 120000, Unit: "files", Path: "monorepo"},
+			expected:    "The analysis target monorepo contains 120000 files, which exceeds the limit of 100000 files.",
+		},
+		{
+			description: "repository file count",
+			new:         scm.NewFilesLimitExceededErrorWithParams,
+			params:      snyk_errors.LimitParams{Limit: 4500000, Actual: 5000000, Unit: "files"},
+			expected:    "The repository contains 5000000 files, which exceeds the limit of 4500000 files.",
+		},
+		{
+			description: "file path length",
+			new:         uploadrevision.NewFilePathTooLongErrorWithParams,
+			params:      snyk_errors.LimitParams{Limit: 255, Actual: 300, Unit: "characters"},
+			expected:    "A file path is 300 characters long, which exceeds the limit of 255 characters.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := tt.new(tt.params, snyk_errors.WithMeta("foo", "bar"))
+
+			require.Equal(t, tt.expected, err.Detail)
+			require.Equal(t, "bar", err.Meta["foo"])
+
+			params, ok := err.LimitParams()
+			require.True(t, ok)
+			require.Equal(t, tt.params, params)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorLimitParams(t *testing.T) {
+	var err Error
+
+	_, ok := err.LimitParams()
+	require.False(t, ok)
+
+	params := LimitParams{Limit: 1024, Actual: 2048, Unit: "bytes", Path: "a.bin"}
+	WithLimitParams(params)(&err)
+
+	actual, ok := err.LimitParams()
+	require.True(t, ok)
+	require.Equal(t, params, actual)
+
+	var buf bytes.Buffer
+	require.NoError(t, err.MarshalToJSONAPIError(&buf, "instance"))
+
+	errs, decodeErr := FromJSONAPIErrorBytes(buf.Bytes())
+	require.NoError(t, decodeErr)
+
+	actual, ok = errs[0].LimitParams()
+	require.True(t, ok)
+	require.Equal(t, params, actual)
+}
+
+func TestWithLimitParamsOmitsOptionalValues(t *testing.T) {
+	var err Error
+
+	WithLimitParams(LimitParams{Limit: 10})(&err)
+
+	require.Equal(t, map[string]any{MetaKeyLimit: int64(10)}, err.Meta)
+}
+
+func TestRenderDetail(t *testing.T) {
+	tmpl := MustParseDetail("TEST-0001", "{{.Actual}} of {{.Limit}} {{.Unit}}")
+
+	require.Equal(t, "3 of 2 files", RenderDetail(tmpl, LimitParams{Limit: 2, Actual: 3, Unit: "files"}))
+	require.Equal(t, "map[]", RenderDetail(tmpl, map[string]any{}))
+}
+
+func TestRenderDetailWithoutUnit(t *testing.T) {
+	tmpl := MustParseDetail("TEST-0001", "{{.ActualWithUnit}} exceeds the limit of {{.LimitWithUnit}}.")
+
+	require.Equal(t, "3 exceeds the limit of 2.", RenderDetail(tmpl, LimitParams{Limit: 2, Actual: 3}))
+	require.Equal(t, "3 files exceeds the limit of 2 files.", RenderDetail(tmpl, LimitParams{Limit: 2, Actual: 3, Unit: "files"}))
+}
@@ -0,0 +1,46 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/snyk/error-catalog-golang-public/internal/problem"
+)
+
+// ProblemJSONContentType is the media type of problem details documents as
+// defined by RFC 9457.
+const ProblemJSONContentType = "application/problem+json"
+
+// MarshalToProblemJSON encodes err as a problem details document, to be served
+// with ProblemJSONContentType. instance identifies the occurrence of the
+// problem, for example the path of the request.
+func (e Error) MarshalToProblemJSON(w io.Writer, instance string) error {
+	return json.NewEncoder(w).Encode(problem.Document{
+		Type:           e.Type,
+		Title:          e.Title,
+		Status:         e.StatusCode,
+		Detail:         e.Detail,
+		Instance:       instance,
+		ErrorCode:      e.ErrorCode,
+		Classification: e.Classification,
+		Level:          e.Level,
+		Links:          e.Links,
+		Logs:           e.Logs,
+		Meta:           e.Meta,
+	})
+}
@@ -0,0 +1,56 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalToProblemJSON(t *testing.T) {
+	err := Error{
+		Type:           "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-0003",
+		Title:          "Client request cannot be processed",
+		StatusCode:     400,
+		ErrorCode:      "SNYK-0003",
+		Detail:         "invalid org",
+		Level:          "error",
+		Classification: "ACTIONABLE",
+		Logs:           []string{"a"},
+		Meta:           map[string]any{"foo": "bar"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, err.MarshalToProblemJSON(&buf, "/orgs/1"))
+
+	var actual map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &actual))
+	require.Equal(t, map[string]any{
+		"type":           "https://docs.snyk.io/scan-with-snyk/error-catalog#snyk-0003",
+		"title":          "Client request cannot be processed",
+		"status":         float64(400),
+		"detail":         "invalid org",
+		"instance":       "/orgs/1",
+		"errorCode":      "SNYK-0003",
+		"classification": "ACTIONABLE",
+		"level":          "error",
+		"logs":           []any{"a"},
+		"meta":           map[string]any{"foo": "bar"},
+	}, actual)
+}
@@ -0,0 +1,75 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+// Candidate is a catalog error found in an error chain by MostRelevant.
+type Candidate struct {
+	Error Error
+	// Depth is the number of unwrapping steps from the root of the chain to
+	// the error, 0 for the root itself.
+	Depth int
+}
+
+// RankingPolicy compares the relevance of two candidates to the user. It
+// returns a positive number if a is more relevant than b, a negative number
+// if it is less relevant and 0 if neither is preferred.
+type RankingPolicy func(a, b Candidate) int
+
+var classificationRelevance = map[string]int{"UNEXPECTED": 1, "UNSUPPORTED": 2, "ACTIONABLE": 3}
+
+// DefaultRankingPolicy prefers ACTIONABLE errors, which tell the user what to
+// do, over UNSUPPORTED and then UNEXPECTED ones, such as generic wrappers
+// added on the way up the call stack. Among errors of the same
+// classification it prefers the higher level, and then the deeper, more
+// specific error.
+func DefaultRankingPolicy(a, b Candidate) int {
+	if d := classificationRelevance[a.Error.Classification] - classificationRelevance[b.Error.Classification]; d != 0 {
+		return d
+	}
+
+	if d := levelSeverity[a.Error.Level] - levelSeverity[b.Error.Level]; d != 0 {
+		return d
+	}
+
+	return a.Depth - b.Depth
+}
+
+// MostRelevant returns the catalog error of the chain of err which is most
+// useful to the user according to DefaultRankingPolicy. It returns false if
+// the chain holds no catalog error.
+func MostRelevant(err error) (Error, bool) {
+	return MostRelevantBy(err, DefaultRankingPolicy)
+}
+
+// MostRelevantBy returns the catalog error of the chain of err ranked highest
+// by policy. The chain is walked depth first, following both Unwrap() error
+// and Unwrap() []error; of equally ranked errors the first one found wins.
+func MostRelevantBy(err error, policy RankingPolicy) (Error, bool) {
+	var best *Candidate
+
+	walk(err, 0, func(c Candidate) bool {
+		if best == nil || policy(c, *best) > 0 {
+			best = &c
+		}
+		return true
+	})
+
+	if best == nil {
+		return Error{}, false
+	}
+
+	return best.Error, true
+}
@@ -0,0 +1,121 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/snyk/error-catalog-golang-public/opensource/ecosystems"
+	"github.com/snyk/error-catalog-golang-public/prchecks"
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+func TestMostRelevant(t *testing.T) {
+	manifest := ecosystems.NewUnparseableManifestError("package.json")
+	wrapper := prchecks.NewFailedToCompleteTestError("", snyk_errors.WithCause(fmt.Errorf("testing: %w", manifest)))
+
+	type test struct {
+		description string
+		err         error
+		expected    string
+		ok          bool
+	}
+
+	tests := []test{
+		{
+			description: "actionable error below an unexpected wrapper",
+			err:         wrapper,
+			expected:    manifest.ErrorCode,
+			ok:          true,
+		},
+		{
+			description: "pointer to a catalog error",
+			err:         fmt.Errorf("scan: %w", &wrapper),
+			expected:    manifest.ErrorCode,
+			ok:          true,
+		},
+		{
+			description: "higher level among the same classification",
+			err: errors.Join(
+				snyk_errors.Error{ErrorCode: "WARN", Level: "warn", Classification: "ACTIONABLE"},
+				snyk_errors.Error{ErrorCode: "FATAL", Level: "fatal", Classification: "ACTIONABLE"},
+			),
+			expected: "FATAL",
+			ok:       true,
+		},
+		{
+			description: "deeper error among equal ones",
+			err: snyk_errors.Error{ErrorCode: "OUTER", Level: "error", Classification: "UNEXPECTED",
+				Cause: snyk_errors.Error{ErrorCode: "INNER", Level: "error", Classification: "UNEXPECTED"}},
+			expected: "INNER",
+			ok:       true,
+		},
+		{
+			description: "first found among equally ranked",
+			err: errors.Join(
+				snyk_errors.Error{ErrorCode: "FIRST", Level: "error", Classification: "UNSUPPORTED"},
+				snyk_errors.Error{ErrorCode: "SECOND", Level: "error", Classification: "UNSUPPORTED"},
+			),
+			expected: "FIRST",
+			ok:       true,
+		},
+		{
+			description: "multi error",
+			err:         snyk_errors.NewMultiError(wrapper, ecosystems.NewUnparseableLockFileError("", snyk_errors.WithCause(errors.New("eof")))),
+			expected:    manifest.ErrorCode,
+			ok:          true,
+		},
+		{
+			description: "no catalog error",
+			err:         fmt.Errorf("wrapped: %w", errors.New("plain")),
+		},
+		{
+			description: "nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			actual, ok := snyk_errors.MostRelevant(tt.err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, actual.ErrorCode)
+		})
+	}
+}
+
+func TestMostRelevantBy(t *testing.T) {
+	manifest := ecosystems.NewUnparseableManifestError("package.json")
+	wrapper := prchecks.NewFailedToCompleteTestError("", snyk_errors.WithCause(manifest))
+
+	outermost := func(a, b snyk_errors.Candidate) int {
+		return b.Depth - a.Depth
+	}
+
+	actual, ok := snyk_errors.MostRelevantBy(wrapper, outermost)
+	require.True(t, ok)
+	require.Equal(t, wrapper.ErrorCode, actual.ErrorCode)
+
+	var compared [][2]int
+	snyk_errors.MostRelevantBy(fmt.Errorf("scan: %w", wrapper), func(a, b snyk_errors.Candidate) int {
+		compared = append(compared, [2]int{a.Depth, b.Depth})
+		return 0
+	})
+	require.Equal(t, [][2]int{{2, 1}}, compared, "the manifest error is compared with the wrapper")
+}
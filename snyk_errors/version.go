@@ -0,0 +1,22 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package snyk_errors
+
+// CatalogVersion is the version of the Error Catalog this module was generated
+// from: the semantic version of the catalog with a hash of its content as
+// build metadata. It is added to the meta of JSON:API errors, so that peers
+// can tell which catalog an error was encoded with.
+const CatalogVersion = "1.0.0+005d56247ef9"
@@ -0,0 +1,86 @@
+/*
+ * © 2026 Snyk Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the 'License');
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an 'AS IS' BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package uploadrevision
+
+import (
+	"github.com/snyk/error-catalog-golang-public/snyk_errors"
+)
+
+var (
+	fileTooLargeDetail                              = snyk_errors.MustParseDetail("SNYK-UPLOAD-REVISION-0003", "{{if .Path}}The file {{.Path}}{{else}}A file{{end}}{{if .Actual}} is {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	totalFilesSizeLimitExceededDetail               = snyk_errors.MustParseDetail("SNYK-UPLOAD-REVISION-0004", "The request{{if .Actual}} contains {{.ActualWithUnit}} of files, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	fileCountLimitExceededDetail                    = snyk_errors.MustParseDetail("SNYK-UPLOAD-REVISION-0005", "The request{{if .Actual}} contains {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	filePathTooLongDetail                           = snyk_errors.MustParseDetail("SNYK-UPLOAD-REVISION-0006", "{{if .Path}}The file path {{.Path}}{{else}}A file path{{end}}{{if .Actual}} is {{.ActualWithUnit}} long, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	populateRequestLimitExceededDetail              = snyk_errors.MustParseDetail("SNYK-UPLOAD-REVISION-0007", "The upload revision{{if .Actual}} received {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	totalUploadRevisionFileCountLimitExceededDetail = snyk_errors.MustParseDetail("SNYK-UPLOAD-REVISION-0008", "The upload revision{{if .Actual}} contains {{.ActualWithUnit}}, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+	totalUploadRevisionSizeLimitExceededDetail      = snyk_errors.MustParseDetail("SNYK-UPLOAD-REVISION-0009", "The upload revision{{if .Actual}} contains {{.ActualWithUnit}} of files, which{{end}} exceeds the limit of {{.LimitWithUnit}}.")
+)
+
+// NewFileTooLargeErrorWithParams is NewFileTooLargeError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewFileTooLargeErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewFileTooLargeError(snyk_errors.RenderDetail(fileTooLargeDetail, params), options...)
+}
+
+// NewTotalFilesSizeLimitExceededErrorWithParams is NewTotalFilesSizeLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewTotalFilesSizeLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewTotalFilesSizeLimitExceededError(snyk_errors.RenderDetail(totalFilesSizeLimitExceededDetail, params), options...)
+}
+
+// NewFileCountLimitExceededErrorWithParams is NewFileCountLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewFileCountLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewFileCountLimitExceededError(snyk_errors.RenderDetail(fileCountLimitExceededDetail, params), options...)
+}
+
+// NewFilePathTooLongErrorWithParams is NewFilePathTooLongError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewFilePathTooLongErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewFilePathTooLongError(snyk_errors.RenderDetail(filePathTooLongDetail, params), options...)
+}
+
+// NewPopulateRequestLimitExceededErrorWithParams is NewPopulateRequestLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewPopulateRequestLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewPopulateRequestLimitExceededError(snyk_errors.RenderDetail(populateRequestLimitExceededDetail, params), options...)
+}
+
+// NewTotalUploadRevisionFileCountLimitExceededErrorWithParams is NewTotalUploadRevisionFileCountLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewTotalUploadRevisionFileCountLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewTotalUploadRevisionFileCountLimitExceededError(snyk_errors.RenderDetail(totalUploadRevisionFileCountLimitExceededDetail, params), options...)
+}
+
+// NewTotalUploadRevisionSizeLimitExceededErrorWithParams is NewTotalUploadRevisionSizeLimitExceededError with a detail rendered from
+// params, which are also stored as structured meta.
+func NewTotalUploadRevisionSizeLimitExceededErrorWithParams(params snyk_errors.LimitParams, options ...snyk_errors.Option) snyk_errors.Error {
+	options = append([]snyk_errors.Option{snyk_errors.WithLimitParams(params)}, options...)
+
+	return NewTotalUploadRevisionSizeLimitExceededError(snyk_errors.RenderDetail(totalUploadRevisionSizeLimitExceededDetail, params), options...)
+}